@@ -0,0 +1,95 @@
+package bricklink
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/thelolagemann/go-rebrickable"
+	"github.com/thelolagemann/go-rebrickable/internal/catalogtest"
+)
+
+var ctx = context.Background()
+
+func color(id int, name string, bricklink int) rebrickable.Color {
+	c := catalogtest.Color(id, rebrickable.SystemBrickLink, bricklink)
+	c.Name = name
+	return c
+}
+
+func part(partNum string, bricklink ...string) rebrickable.Part {
+	p := rebrickable.Part{PartNum: partNum}
+	p.ExternalIds.BrickLink = bricklink
+	return p
+}
+
+var (
+	red   = color(4, "Red", 5)
+	black = color(0, "Black", 11)
+)
+
+func TestNewWantedList(t *testing.T) {
+	parts := []rebrickable.InventoryPart{
+		{Part: part("3001", "3001"), Color: red, Quantity: 4},
+		{Part: part("3001", "3001"), Color: red, Quantity: 2},
+		{Part: part("3023", "3023"), Color: black, Quantity: 1, IsSpare: true},
+		{Part: part("3626cpr0001"), Color: black, Quantity: 1},
+	}
+
+	list, unmapped := NewWantedList(parts, ExportOptions{Condition: ConditionNew})
+	if len(list.Items) != 1 {
+		t.Fatalf("expected 1 item, got %+v", list.Items)
+	}
+	if item := list.Items[0]; item.ItemID != "3001" || item.Color != 5 || item.MinQty != 6 || item.Condition != "N" {
+		t.Errorf("unexpected item %+v", item)
+	}
+	if len(unmapped) != 1 || unmapped[0].Part.PartNum != "3626cpr0001" {
+		t.Errorf("unexpected unmapped %+v", unmapped)
+	}
+
+	var buf bytes.Buffer
+	if err := list.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<INVENTORY>", "<ITEMTYPE>P</ITEMTYPE>", "<MINQTY>6</MINQTY>"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected %q in %v", want, buf.String())
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	list, err := Decode(strings.NewReader(`<INVENTORY>
+  <ITEM><ITEMTYPE>P</ITEMTYPE><ITEMID>3001</ITEMID><COLOR>5</COLOR><MINQTY>4</MINQTY></ITEM>
+  <ITEM><ITEMTYPE>P</ITEMTYPE><ITEMID>3001</ITEMID><COLOR>5</COLOR></ITEM>
+  <ITEM><ITEMTYPE>P</ITEMTYPE><ITEMID>3794a</ITEMID><COLOR>11</COLOR><MINQTY>2</MINQTY></ITEM>
+  <ITEM><ITEMTYPE>P</ITEMTYPE><ITEMID>3023</ITEMID><COLOR>999</COLOR></ITEM>
+  <ITEM><ITEMTYPE>P</ITEMTYPE><ITEMID>99999</ITEMID><COLOR>5</COLOR></ITEM>
+  <ITEM><ITEMTYPE>S</ITEMTYPE><ITEMID>7018-1</ITEMID></ITEM>
+</INVENTORY>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	catalog := catalogtest.New(
+		[]rebrickable.Color{red, black},
+		[]rebrickable.Part{part("3001", "3001"), part("3023", "3023"), part("15573", "3794a", "3794b")},
+	)
+	parts, unmapped, err := Resolve(ctx, catalog, list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 2 {
+		t.Fatalf("expected 2 parts, got %+v", parts)
+	}
+	if p := parts[0]; p.Part.PartNum != "3001" || p.Color.ID != 4 || p.Quantity != 5 {
+		t.Errorf("unexpected part %+v", p)
+	}
+	if p := parts[1]; p.Part.PartNum != "15573" || p.Color.ID != 0 || p.Quantity != 2 {
+		t.Errorf("unexpected part %+v", p)
+	}
+	if len(unmapped) != 3 || unmapped[0].ItemID != "3023" || unmapped[1].ItemID != "99999" || unmapped[2].ItemType != ItemTypeSet {
+		t.Errorf("unexpected unmapped %+v", unmapped)
+	}
+}
@@ -0,0 +1,144 @@
+package bricklink
+
+import (
+	"context"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+// ExportOptions control how an inventory is converted to a WantedList.
+type ExportOptions struct {
+	// WantedListID adds the items to an existing wanted list,
+	// rather than the default one.
+	WantedListID string
+
+	// Condition is the condition wanted for every item, either
+	// ConditionNew or ConditionUsed. Empty accepts any condition.
+	Condition string
+
+	// IncludeSpares includes spare parts in the WantedList.
+	IncludeSpares bool
+
+	// Notify subscribes to notifications for every item.
+	Notify bool
+}
+
+type itemKey struct {
+	itemID string
+	color  int
+}
+
+type partKey struct {
+	partNum string
+	colorID int
+}
+
+// NewWantedList converts parts into a WantedList, combining the
+// quantities of parts which map to the same BrickLink item and color.
+// Parts without a BrickLink part or color ID are returned as unmapped,
+// so parts should be fetched with their part and color details.
+func NewWantedList(parts []rebrickable.InventoryPart, opts ExportOptions) (*WantedList, []rebrickable.InventoryPart) {
+	list := &WantedList{}
+	var unmapped []rebrickable.InventoryPart
+
+	index := make(map[itemKey]int)
+	for _, part := range parts {
+		if part.IsSpare && !opts.IncludeSpares {
+			continue
+		}
+		itemID, color, ok := bricklinkIDs(part)
+		if !ok {
+			unmapped = append(unmapped, part)
+			continue
+		}
+
+		key := itemKey{itemID, color}
+		if i, ok := index[key]; ok {
+			list.Items[i].MinQty += part.Quantity
+			continue
+		}
+		item := Item{
+			ItemType:     ItemTypePart,
+			ItemID:       itemID,
+			Color:        color,
+			MinQty:       part.Quantity,
+			Condition:    opts.Condition,
+			WantedListID: opts.WantedListID,
+			Notify:       "N",
+		}
+		if opts.Notify {
+			item.Notify = "Y"
+		}
+		index[key] = len(list.Items)
+		list.Items = append(list.Items, item)
+	}
+
+	return list, unmapped
+}
+
+// bricklinkIDs returns the BrickLink item and color IDs of part.
+// When a part maps to several BrickLink items, the one matching the
+// Rebrickable part number is preferred.
+func bricklinkIDs(part rebrickable.InventoryPart) (string, int, bool) {
+	ids := part.Part.ExternalIds.BrickLink
+	color, ok := part.Color.BrickLinkID()
+	if len(ids) == 0 || !ok {
+		return "", 0, false
+	}
+	itemID := ids[0]
+	for _, id := range ids {
+		if id == part.Part.PartNum {
+			itemID = id
+		}
+	}
+	return itemID, color, true
+}
+
+// Resolve maps the items of list back to Rebrickable parts and colors,
+// translating parts from their BrickLink ID with a Translator. Items
+// which are not parts, or whose part or color is unknown to catalog,
+// are returned as unmapped.
+func Resolve(ctx context.Context, catalog rebrickable.Catalog, list *WantedList) ([]rebrickable.InventoryPart, []Item, error) {
+	colors, err := rebrickable.LoadColorIndex(ctx, catalog)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		parts    []rebrickable.InventoryPart
+		unmapped []Item
+	)
+	translator := rebrickable.NewTranslator(catalog)
+	index := make(map[partKey]int)
+	for _, item := range list.Items {
+		if item.ItemType != ItemTypePart {
+			unmapped = append(unmapped, item)
+			continue
+		}
+
+		translation, err := translator.Translate(ctx, rebrickable.SystemBrickLink, item.ItemID)
+		if err != nil {
+			return nil, nil, err
+		}
+		color, ok := colors.ColorByExternalID(rebrickable.SystemBrickLink, item.Color)
+		if !translation.Found() || !ok {
+			unmapped = append(unmapped, item)
+			continue
+		}
+		part := translation.Part()
+
+		quantity := item.MinQty
+		if quantity < 1 {
+			quantity = 1
+		}
+		key := partKey{part.PartNum, color.ID}
+		if i, ok := index[key]; ok {
+			parts[i].Quantity += quantity
+			continue
+		}
+		index[key] = len(parts)
+		parts = append(parts, rebrickable.InventoryPart{Part: part, Color: color, Quantity: quantity})
+	}
+
+	return parts, unmapped, nil
+}
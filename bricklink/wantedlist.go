@@ -0,0 +1,65 @@
+// Package bricklink converts inventories to and from BrickLink
+// wanted list XML, mapping parts and colors through the BrickLink
+// external IDs known to Rebrickable.
+package bricklink
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// Item types used by BrickLink.
+const (
+	ItemTypePart    = "P"
+	ItemTypeSet     = "S"
+	ItemTypeMinifig = "M"
+)
+
+// Item conditions used by BrickLink. An empty condition accepts
+// items in any condition.
+const (
+	ConditionNew  = "N"
+	ConditionUsed = "U"
+)
+
+// WantedList is a BrickLink wanted list, as uploaded to and
+// downloaded from https://www.bricklink.com/v2/wanted/upload.page.
+type WantedList struct {
+	XMLName xml.Name `xml:"INVENTORY"`
+	Items   []Item   `xml:"ITEM"`
+}
+
+// Item is a single item of a WantedList.
+type Item struct {
+	ItemType     string  `xml:"ITEMTYPE"`
+	ItemID       string  `xml:"ITEMID"`
+	Color        int     `xml:"COLOR,omitempty"`
+	MaxPrice     float64 `xml:"MAXPRICE,omitempty"`
+	MinQty       int     `xml:"MINQTY,omitempty"`
+	QtyFilled    int     `xml:"QTYFILLED,omitempty"`
+	Condition    string  `xml:"CONDITION,omitempty"`
+	Remarks      string  `xml:"REMARKS,omitempty"`
+	Notify       string  `xml:"NOTIFY,omitempty"`
+	WantedShow   string  `xml:"WANTEDSHOW,omitempty"`
+	WantedListID string  `xml:"WANTEDLISTID,omitempty"`
+}
+
+// Decode reads a WantedList from r.
+func Decode(r io.Reader) (*WantedList, error) {
+	var list WantedList
+	if err := xml.NewDecoder(r).Decode(&list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Encode writes the WantedList to w as indented XML.
+func (l *WantedList) Encode(w io.Writer) error {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(l); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
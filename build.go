@@ -0,0 +1,169 @@
+package rebrickable
+
+import (
+	"context"
+	"net/url"
+)
+
+// Build get how much of a specific Set can be built using the
+// parts in the user's collection, using the build options set
+// on the user's Rebrickable account.
+func (u *UserClient) Build(ctx context.Context, setNumber string) (build BuildResult, err error) {
+	err = u.c.get(ctx, u.endpoint("build/%v/", url.PathEscape(setNumber)), false, &build)
+	return
+}
+
+type BuildResult struct {
+	User                  int          `json:"user"`
+	Inventory             int          `json:"inventory"`
+	UserList              int          `json:"user_list"`
+	PctOwned              float64      `json:"pct_owned"`
+	NumMissing            int          `json:"num_missing"`
+	NumIgnored            int          `json:"num_ignored"`
+	NumOwnedLessIgnored   int          `json:"num_owned_less_ignored"`
+	TotalParts            int          `json:"total_parts"`
+	TotalPartsLessIgnored int          `json:"total_parts_less_ignored"`
+	BuildOptions          BuildOptions `json:"build_options"`
+}
+
+type BuildOptions struct {
+	IgnorePrint    bool `json:"ignore_print"`
+	IgnoreMold     bool `json:"ignore_mold"`
+	IgnoreAltp     bool `json:"ignore_altp"`
+	IgnoreMinifigs bool `json:"ignore_minifigs"`
+	IgnoreNonLego  bool `json:"ignore_non_lego"`
+	SortBy         int  `json:"sort_by"`
+	Color          int  `json:"color"`
+	Theme          int  `json:"theme"`
+	MinParts       int  `json:"min_parts"`
+	MaxParts       int  `json:"max_parts"`
+	MinYear        int  `json:"min_year"`
+	MaxYear        int  `json:"max_year"`
+	AddedDaysAgo   int  `json:"added_days_ago"`
+	IncOfficial    bool `json:"inc_official"`
+	IncCustom      bool `json:"inc_custom"`
+	IncBmodels     bool `json:"inc_bmodels"`
+	IncAccessory   bool `json:"inc_accessory"`
+	IncPremium     bool `json:"inc_premium"`
+	IncAlts        bool `json:"inc_alts"`
+	IncOwned       bool `json:"inc_owned"`
+}
+
+// BuildCheckOptions control how CheckBuild matches parts.
+type BuildCheckOptions struct {
+	// IncludeSpares counts the spare parts of the required
+	// inventory as required.
+	IncludeSpares bool
+
+	// IgnoreColor matches parts regardless of their color.
+	IgnoreColor bool
+
+	// IgnorePrint allows a printed part to be substituted
+	// by its unprinted version, and vice versa.
+	IgnorePrint bool
+
+	// IgnoreMold allows a part to be substituted by any
+	// of its alternate molds. Molds are only listed with the
+	// part details, so the inventories should be fetched with
+	// IncPartDetails(true).
+	IgnoreMold bool
+}
+
+// BuildCheck is the result of CheckBuild.
+type BuildCheck struct {
+	TotalParts int
+	NumOwned   int
+	NumMissing int
+	PctOwned   float64
+
+	// Missing lists the shortfall of each required InventoryPart,
+	// with its Quantity set to the number of parts missing.
+	Missing []InventoryPart
+}
+
+// CheckBuild computes, without calling the API, how much of the
+// required inventory (such as the result of SetParts) is covered by
+// the parts in have. have may be any inventory, such as the parts
+// of a PartList, a user's AllParts or the inventories of other sets.
+func CheckBuild(required, have []InventoryPart, opts BuildCheckOptions) BuildCheck {
+	groups := partGroups{}
+	if opts.IgnorePrint || opts.IgnoreMold {
+		for _, inv := range [][]InventoryPart{required, have} {
+			for _, p := range inv {
+				if opts.IgnorePrint && p.Part.PrintOf != "" {
+					groups.union(p.Part.PartNum, p.Part.PrintOf)
+				}
+				if opts.IgnoreMold {
+					for _, mold := range p.Part.Molds {
+						groups.union(p.Part.PartNum, mold)
+					}
+				}
+			}
+		}
+	}
+
+	type key struct {
+		part  string
+		color int
+	}
+	keyOf := func(p InventoryPart) key {
+		k := key{groups.find(p.Part.PartNum), p.Color.ID}
+		if opts.IgnoreColor {
+			k.color = 0
+		}
+		return k
+	}
+
+	available := make(map[key]int)
+	for _, p := range have {
+		available[keyOf(p)] += p.Quantity
+	}
+
+	var check BuildCheck
+	for _, p := range required {
+		if p.IsSpare && !opts.IncludeSpares {
+			continue
+		}
+
+		k := keyOf(p)
+		owned := p.Quantity
+		if available[k] < owned {
+			owned = available[k]
+		}
+		available[k] -= owned
+
+		check.TotalParts += p.Quantity
+		check.NumOwned += owned
+		if missing := p.Quantity - owned; missing > 0 {
+			p.Quantity = missing
+			check.NumMissing += missing
+			check.Missing = append(check.Missing, p)
+		}
+	}
+	if check.TotalParts > 0 {
+		check.PctOwned = float64(check.NumOwned) / float64(check.TotalParts) * 100
+	}
+
+	return check
+}
+
+// partGroups is a union-find of part numbers which can be
+// substituted for one another.
+type partGroups map[string]string
+
+func (g partGroups) find(part string) string {
+	for {
+		parent, ok := g[part]
+		if !ok || parent == part {
+			return part
+		}
+		part = parent
+	}
+}
+
+func (g partGroups) union(a, b string) {
+	ra, rb := g.find(a), g.find(b)
+	if ra != rb {
+		g[ra] = rb
+	}
+}
@@ -0,0 +1,58 @@
+package rebrickable
+
+import "testing"
+
+func TestUserClient_Build(t *testing.T) {
+	if build, err := user.Build(ctx, "7018-1"); err != nil {
+		t.Error(err)
+	} else if build.TotalParts != 580 || !build.BuildOptions.IgnorePrint {
+		t.Errorf("unexpected build result: %+v", build)
+	}
+}
+
+func TestCheckBuild(t *testing.T) {
+	inv := func(part, printOf string, color, quantity int, spare bool) InventoryPart {
+		return InventoryPart{
+			Part:     Part{PartNum: part, PrintOf: printOf},
+			Color:    Color{ID: color},
+			Quantity: quantity,
+			IsSpare:  spare,
+		}
+	}
+	required := []InventoryPart{
+		inv("3001", "", 4, 4, false),
+		inv("3001", "", 4, 1, true),
+		inv("3068bpr0001", "3068b", 15, 2, false),
+	}
+	have := []InventoryPart{
+		inv("3001", "", 4, 3, false),
+		inv("3001", "", 1, 2, false),
+		inv("3068b", "", 15, 5, false),
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		check := CheckBuild(required, have, BuildCheckOptions{})
+		if check.TotalParts != 6 || check.NumOwned != 3 || check.NumMissing != 3 {
+			t.Errorf("unexpected check: %+v", check)
+		}
+		if len(check.Missing) != 2 || check.Missing[0].Quantity != 1 || check.Missing[1].Quantity != 2 {
+			t.Errorf("unexpected missing parts: %+v", check.Missing)
+		}
+	})
+	t.Run("IncludeSpares", func(t *testing.T) {
+		if check := CheckBuild(required, have, BuildCheckOptions{IncludeSpares: true}); check.TotalParts != 7 || check.NumMissing != 4 {
+			t.Errorf("unexpected check: %+v", check)
+		}
+	})
+	t.Run("IgnoreColor", func(t *testing.T) {
+		if check := CheckBuild(required, have, BuildCheckOptions{IgnoreColor: true}); check.NumMissing != 2 {
+			t.Errorf("unexpected check: %+v", check)
+		}
+	})
+	t.Run("IgnorePrint", func(t *testing.T) {
+		check := CheckBuild(required, have, BuildCheckOptions{IgnoreColor: true, IgnorePrint: true})
+		if check.NumMissing != 0 || check.PctOwned != 100 {
+			t.Errorf("unexpected check: %+v", check)
+		}
+	})
+}
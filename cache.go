@@ -0,0 +1,293 @@
+package rebrickable
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores the responses of GET requests. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored for key, if any.
+	Get(key string) (CacheEntry, bool)
+
+	// Set stores entry for key.
+	Set(key string, entry CacheEntry)
+}
+
+// CacheEntry is a cached response body, along with the validators
+// used to revalidate it once it has expired.
+type CacheEntry struct {
+	Body         []byte    `json:"body"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Expires      time.Time `json:"expires"`
+}
+
+// defaultCacheTTLs cache catalogue data which rarely changes for a
+// day.
+var defaultCacheTTLs = map[string]time.Duration{
+	"lego/colors":            24 * time.Hour,
+	"lego/colors/*":          24 * time.Hour,
+	"lego/part_categories":   24 * time.Hour,
+	"lego/part_categories/*": 24 * time.Hour,
+	"lego/parts/*":           24 * time.Hour,
+	"lego/themes":            24 * time.Hour,
+	"lego/themes/*":          24 * time.Hour,
+}
+
+// DefaultCacheTTLs returns a copy of the TTLs used by ResponseCache
+// when none are given, which can be modified and passed to it.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return copyTTLs(defaultCacheTTLs)
+}
+
+func copyTTLs(ttls map[string]time.Duration) map[string]time.Duration {
+	c := make(map[string]time.Duration, len(ttls))
+	for endpoint, ttl := range ttls {
+		c[endpoint] = ttl
+	}
+	return c
+}
+
+// ResponseCache cache the responses of GET requests in cache. ttls
+// maps each endpoint to cache, with path parameters replaced by *
+// (e.g. "lego/parts/*"), to how long its responses are fresh for.
+// Endpoints not in ttls are never cached. If ttls is nil, the
+// DefaultCacheTTLs are used. ttls is copied, so later changes to it
+// have no effect.
+//
+// Once an entry has expired, it is revalidated with a conditional
+// request if the API returned an ETag or Last-Modified header.
+func ResponseCache(cache Cache, ttls map[string]time.Duration) ClientOption {
+	if ttls == nil {
+		ttls = defaultCacheTTLs
+	}
+	ttls = copyTTLs(ttls)
+	return func(c *Client) {
+		c.cache = &responseCache{cache, ttls}
+	}
+}
+
+type responseCache struct {
+	Cache
+	ttls map[string]time.Duration
+}
+
+// ttl returns how long responses from endpoint are cached for,
+// and whether they are cached at all.
+func (rc *responseCache) ttl(endpoint string) (time.Duration, bool) {
+	if endpoint == "" {
+		return 0, false
+	}
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	var (
+		best  string
+		ttl   time.Duration
+		found bool
+	)
+	for pattern, d := range rc.ttls {
+		if matchSegments(strings.Split(pattern, "/"), segments) && (!found || moreSpecific(pattern, best)) {
+			best, ttl, found = pattern, d, true
+		}
+	}
+	return ttl, found && ttl > 0
+}
+
+// relative returns the path of u relative to the client's base URL,
+// or an empty string if u does not belong to it.
+func (c *Client) relative(u *url.URL) string {
+	base, err := url.Parse(c.url)
+	if err != nil || u.Host != base.Host || !strings.HasPrefix(u.Path, base.Path) {
+		return ""
+	}
+	return strings.TrimPrefix(u.Path, base.Path)
+}
+
+// cachedDo executes req, serving it from the cache while the cached
+// entry is fresh, and caching the response otherwise. Requests to
+// endpoints which are not cached are executed as they are.
+func (c *Client) cachedDo(req *http.Request) (*http.Response, error) {
+	if c.cache == nil {
+		return c.do(req)
+	}
+	ttl, ok := c.cache.ttl(c.relative(req.URL))
+	if !ok {
+		return c.do(req)
+	}
+
+	key := req.URL.String()
+	entry, ok := c.cache.Get(key)
+	if ok && time.Now().Before(entry.Expires) {
+		return entry.response(), nil
+	}
+
+	// revalidate the expired entry
+	if ok && entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+	if ok && entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified && ok {
+		entry.Expires = time.Now().Add(ttl)
+		c.cache.Set(key, entry)
+		return entry.response(), nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if res.StatusCode == http.StatusOK && res.Header.Get("Content-Type") == "application/json" {
+		c.cache.Set(key, CacheEntry{
+			Body:         body,
+			ETag:         res.Header.Get("ETag"),
+			LastModified: res.Header.Get("Last-Modified"),
+			Expires:      time.Now().Add(ttl),
+		})
+	}
+
+	return res, nil
+}
+
+func (e CacheEntry) response() *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body: ioutil.NopCloser(bytes.NewReader(e.Body)),
+	}
+}
+
+// MemoryCache is an in-memory Cache, evicting the least recently
+// used entry once it holds more than its maximum number of entries.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding up to size entries.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (m *MemoryCache) Get(key string) (CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	m.order.MoveToFront(e)
+	return e.Value.(*memoryCacheItem).entry, true
+}
+
+func (m *MemoryCache) Set(key string, entry CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.entries[key]; ok {
+		e.Value.(*memoryCacheItem).entry = entry
+		m.order.MoveToFront(e)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryCacheItem{key, entry})
+
+	for m.order.Len() > m.size {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// DiskCache is a Cache storing each entry as a file in a directory,
+// allowing cached responses to outlive the process.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache storing entries in dir, which is
+// created if it does not exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *DiskCache) Get(key string) (CacheEntry, bool) {
+	b, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return CacheEntry{}, false
+	}
+	return entry, true
+}
+
+// Set stores entry for key. Failing to write the entry is not an
+// error, the response is simply not cached.
+func (d *DiskCache) Set(key string, entry CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	// write to a temporary file first, so concurrent readers
+	// never see a partially written entry
+	tmp, err := ioutil.TempFile(d.dir, "tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	os.Rename(tmp.Name(), d.path(key))
+}
@@ -0,0 +1,132 @@
+package rebrickable
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	var requests int
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		requests++
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			return mockClientResponse(http.StatusNotModified, ""), nil
+		}
+		res, err := mockResponse(req)
+		res.Header.Set("ETag", `"v1"`)
+		return res, err
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	cache := NewMemoryCache(10)
+	c := NewClient("", HTTPClient(globalMock), ResponseCache(cache, nil))
+
+	t.Run("Fresh", func(t *testing.T) {
+		requests = 0
+		for i := 0; i < 3; i++ {
+			if theme, err := c.Theme(ctx, 3); err != nil {
+				t.Fatal(err)
+			} else if theme.Name != "Competition" {
+				t.Errorf("unexpected theme: %+v", theme)
+			}
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %v", requests)
+		}
+	})
+	t.Run("Revalidate", func(t *testing.T) {
+		key := "https://rebrickable.com/api/v3/lego/themes/3"
+		entry, ok := cache.Get(key)
+		if !ok {
+			t.Fatal("expected response to be cached")
+		}
+		entry.Expires = time.Now().Add(-time.Second)
+		cache.Set(key, entry)
+
+		requests = 0
+		if theme, err := c.Theme(ctx, 3); err != nil {
+			t.Fatal(err)
+		} else if theme.Name != "Competition" {
+			t.Errorf("unexpected theme: %+v", theme)
+		}
+		if entry, _ := cache.Get(key); requests != 1 || !entry.Expires.After(time.Now()) {
+			t.Errorf("expected entry to be revalidated, got %v requests", requests)
+		}
+	})
+	t.Run("NotCached", func(t *testing.T) {
+		requests = 0
+		for i := 0; i < 2; i++ {
+			if _, err := c.Set(ctx, "42102-1"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if requests != 2 {
+			t.Errorf("expected 2 requests, got %v", requests)
+		}
+	})
+}
+
+func TestDefaultCacheTTLs(t *testing.T) {
+	ttls := DefaultCacheTTLs()
+	ttls["lego/colors"] = 0
+	delete(ttls, "lego/themes")
+
+	if ttl := DefaultCacheTTLs()["lego/colors"]; ttl != 24*time.Hour {
+		t.Errorf("expected the defaults to be unchanged, got %v", ttl)
+	}
+	rc := NewClient("", ResponseCache(NewMemoryCache(1), nil)).cache
+	if _, ok := rc.ttl("lego/themes"); !ok {
+		t.Error("expected lego/themes to be cached")
+	}
+}
+
+func TestResponseCache_Overlapping(t *testing.T) {
+	rc := NewClient("", ResponseCache(NewMemoryCache(1), map[string]time.Duration{
+		"lego/parts/*":    time.Hour,
+		"lego/parts/3001": 0,
+	})).cache
+
+	// map order is random, so check repeatedly
+	for i := 0; i < 50; i++ {
+		if _, ok := rc.ttl("lego/parts/3001"); ok {
+			t.Fatal("expected lego/parts/3001 not to be cached")
+		}
+		if ttl, ok := rc.ttl("lego/parts/3002"); !ok || ttl != time.Hour {
+			t.Fatalf("expected lego/parts/3002 to be cached for an hour, got %v", ttl)
+		}
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", CacheEntry{Body: []byte("a")})
+	cache.Set("b", CacheEntry{Body: []byte("b")})
+	cache.Get("a")
+	cache.Set("c", CacheEntry{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected least recently used entry to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if entry, ok := cache.Get(key); !ok || string(entry.Body) != key {
+			t.Errorf("expected %v to be cached", key)
+		}
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected empty cache")
+	}
+
+	expires := time.Now().Add(time.Hour).Round(0)
+	cache.Set("a", CacheEntry{Body: []byte(`{}`), ETag: `"v1"`, Expires: expires})
+	if entry, ok := cache.Get("a"); !ok || string(entry.Body) != `{}` || entry.ETag != `"v1"` || !entry.Expires.Equal(expires) {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+}
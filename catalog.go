@@ -0,0 +1,35 @@
+package rebrickable
+
+import "context"
+
+// Catalog describes the read-only catalogue queries answered by
+// Client, so that callers can swap between the live API and any
+// other source of the same data, such as the offline package.
+type Catalog interface {
+	Colors(ctx context.Context, opts ...RequestOption) ([]Color, error)
+	Color(ctx context.Context, id int, opts ...RequestOption) (Color, error)
+	Element(ctx context.Context, id string) (Element, error)
+	Minifigs(ctx context.Context, opts ...RequestOption) ([]Minifig, error)
+	Minifig(ctx context.Context, setNumber string) (Minifig, error)
+	MinifigParts(ctx context.Context, setNumber string, opts ...RequestOption) ([]InventoryPart, error)
+	MinifigSets(ctx context.Context, setNumber string, opts ...RequestOption) ([]Set, error)
+	MOC(ctx context.Context, setNumber string) (MOC, error)
+	MOCParts(ctx context.Context, setNumber string, opts ...RequestOption) ([]InventoryPart, error)
+	PartCategories(ctx context.Context, opts ...RequestOption) ([]PartCategory, error)
+	PartCategory(ctx context.Context, id int, opts ...RequestOption) (PartCategory, error)
+	Parts(ctx context.Context, opts ...RequestOption) ([]Part, error)
+	Part(ctx context.Context, partNumber string) (Part, error)
+	PartColors(ctx context.Context, partNumber string, opts ...RequestOption) ([]PartColor, error)
+	PartColor(ctx context.Context, partNumber string, colorId int) (PartColor, error)
+	PartColorSets(ctx context.Context, partNumber string, colorId int, opts ...RequestOption) ([]Set, error)
+	Sets(ctx context.Context, opts ...RequestOption) ([]Set, error)
+	Set(ctx context.Context, setNumber string) (Set, error)
+	SetAlternates(ctx context.Context, setNumber string, opts ...RequestOption) ([]MOC, error)
+	SetMinifigs(ctx context.Context, setNumber string, opts ...RequestOption) ([]InventoryMinifig, error)
+	SetParts(ctx context.Context, setNumber string, opts ...RequestOption) ([]InventoryPart, error)
+	SetSets(ctx context.Context, setNumber string, opts ...RequestOption) ([]InventorySet, error)
+	Themes(ctx context.Context, opts ...RequestOption) ([]Theme, error)
+	Theme(ctx context.Context, id int, opts ...RequestOption) (Theme, error)
+}
+
+var _ Catalog = (*Client)(nil)
@@ -0,0 +1,22 @@
+package rebrickable_test
+
+import (
+	"testing"
+
+	"github.com/thelolagemann/go-rebrickable"
+	"github.com/thelolagemann/go-rebrickable/internal/catalogtest"
+)
+
+func TestLoadColorIndex(t *testing.T) {
+	var colors []rebrickable.Color
+	for i := 0; i <= 1000; i++ {
+		colors = append(colors, catalogtest.Color(i, rebrickable.SystemLDraw, i))
+	}
+	index, err := rebrickable.LoadColorIndex(ctx, catalogtest.New(colors, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := index.ColorByExternalID(rebrickable.SystemLDraw, 1000); !ok || c.ID != 1000 {
+		t.Errorf("expected color 1000 from the second page, got %+v", c)
+	}
+}
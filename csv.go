@@ -0,0 +1,185 @@
+package rebrickable
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadPartsCSV reads parts in the Rebrickable part list CSV format,
+// with the columns Part, Color, Quantity and optionally IsSpare, as
+// exported from and imported to part lists on rebrickable.com.
+func ReadPartsCSV(r io.Reader) ([]InventoryPart, error) {
+	var parts []InventoryPart
+	err := readCSV(r, []string{"part", "color", "quantity"}, func(row csvRow) error {
+		color, err := row.int("color")
+		if err != nil {
+			return err
+		}
+		quantity, err := row.int("quantity")
+		if err != nil {
+			return err
+		}
+		part := InventoryPart{Part: Part{PartNum: row.string("part")}, Color: Color{ID: color}, Quantity: quantity}
+		if part.IsSpare, err = row.bool("isspare"); err != nil {
+			return err
+		}
+		parts = append(parts, part)
+		return nil
+	})
+	return parts, err
+}
+
+// WritePartsCSV writes parts in the Rebrickable part list CSV format.
+// The IsSpare column is only written if any of parts is a spare.
+func WritePartsCSV(w io.Writer, parts []InventoryPart) error {
+	spares := false
+	for _, part := range parts {
+		spares = spares || part.IsSpare
+	}
+
+	cw := csv.NewWriter(w)
+	header := []string{"Part", "Color", "Quantity"}
+	if spares {
+		header = append(header, "IsSpare")
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, part := range parts {
+		record := []string{part.Part.PartNum, strconv.Itoa(part.Color.ID), strconv.Itoa(part.Quantity)}
+		if spares {
+			record = append(record, formatCSVBool(part.IsSpare))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadSetsCSV reads sets in the Rebrickable set list CSV format, with
+// the columns Set Number, Quantity and optionally Includes Spares, as
+// exported from and imported to set lists on rebrickable.com. Sets
+// without an Includes Spares column include their spares.
+func ReadSetsCSV(r io.Reader) ([]UserSet, error) {
+	var sets []UserSet
+	err := readCSV(r, []string{"setnumber", "quantity"}, func(row csvRow) error {
+		quantity, err := row.int("quantity")
+		if err != nil {
+			return err
+		}
+		set := UserSet{Set: Set{SetNum: row.string("setnumber")}, Quantity: quantity, IncludeSpares: true}
+		if _, ok := row.header["includesspares"]; ok {
+			if set.IncludeSpares, err = row.bool("includesspares"); err != nil {
+				return err
+			}
+		}
+		sets = append(sets, set)
+		return nil
+	})
+	return sets, err
+}
+
+// WriteSetsCSV writes sets in the Rebrickable set list CSV format.
+func WriteSetsCSV(w io.Writer, sets []UserSet) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"Set Number", "Quantity", "Includes Spares"}); err != nil {
+		return err
+	}
+	for _, set := range sets {
+		if err := cw.Write([]string{set.Set.SetNum, strconv.Itoa(set.Quantity), formatCSVBool(set.IncludeSpares)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvRow is a row of a CSV file, with its columns looked up
+// by their normalised header name.
+type csvRow struct {
+	line   int
+	header map[string]int
+	values []string
+}
+
+func (r csvRow) string(column string) string {
+	if i, ok := r.header[column]; ok && i < len(r.values) {
+		return strings.TrimSpace(r.values[i])
+	}
+	return ""
+}
+
+func (r csvRow) int(column string) (int, error) {
+	v, err := strconv.Atoi(r.string(column))
+	if err != nil {
+		return 0, fmt.Errorf("rebrickable: line %d: invalid %v %q", r.line, column, r.string(column))
+	}
+	return v, nil
+}
+
+// bool parses the boolean column, which is false if empty.
+func (r csvRow) bool(column string) (bool, error) {
+	switch strings.ToLower(r.string(column)) {
+	case "", "false", "f", "0", "no", "n":
+		return false, nil
+	case "true", "t", "1", "yes", "y":
+		return true, nil
+	}
+	return false, fmt.Errorf("rebrickable: line %d: invalid %v %q", r.line, column, r.string(column))
+}
+
+// readCSV calls fn for each row of r, once its header has been
+// checked for the required columns.
+func readCSV(r io.Reader, required []string, fn func(row csvRow) error) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	names, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	header := make(map[string]int, len(names))
+	for i, name := range names {
+		header[normaliseCSVHeader(name)] = i
+	}
+	for _, column := range required {
+		if _, ok := header[column]; !ok {
+			return fmt.Errorf("rebrickable: missing CSV column %q", column)
+		}
+	}
+
+	for line := 2; ; line++ {
+		values, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(csvRow{line, header, values}); err != nil {
+			return err
+		}
+	}
+}
+
+// normaliseCSVHeader lower cases name and strips any byte order
+// mark, spaces and underscores, so that "Set Number", "set_number"
+// and "SetNumber" are all alike.
+func normaliseCSVHeader(name string) string {
+	name = strings.TrimPrefix(name, "\ufeff")
+	name = strings.NewReplacer(" ", "", "_", "").Replace(name)
+	return strings.ToLower(name)
+}
+
+func formatCSVBool(b bool) string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
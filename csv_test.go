@@ -0,0 +1,61 @@
+package rebrickable
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPartsCSV(t *testing.T) {
+	parts, err := ReadPartsCSV(strings.NewReader("\ufeffPart,Color,Quantity,Is Spare\n3001,4,10,False\n3023,15,2,True\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parts) != 2 || parts[0].Part.PartNum != "3001" || parts[0].Color.ID != 4 || parts[0].Quantity != 10 || !parts[1].IsSpare {
+		t.Errorf("unexpected parts %+v", parts)
+	}
+
+	var buf bytes.Buffer
+	if err := WritePartsCSV(&buf, parts); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Part,Color,Quantity,IsSpare\n3001,4,10,False\n3023,15,2,True\n"; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+
+	t.Run("WithoutSpares", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := WritePartsCSV(&buf, parts[:1]); err != nil {
+			t.Fatal(err)
+		}
+		if want := "Part,Color,Quantity\n3001,4,10\n"; buf.String() != want {
+			t.Errorf("expected %q, got %q", want, buf.String())
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		if _, err := ReadPartsCSV(strings.NewReader("Part,Color,Quantity\n3001,red,1\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("expected error on line 2, got %v", err)
+		}
+		if _, err := ReadPartsCSV(strings.NewReader("Part,Quantity\n3001,1\n")); err == nil {
+			t.Error("expected missing column error")
+		}
+	})
+}
+
+func TestSetsCSV(t *testing.T) {
+	sets, err := ReadSetsCSV(strings.NewReader("Set Number,Quantity,Includes Spares\n7018-1,1,False\n42102-1,2,True\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 || sets[0].Set.SetNum != "7018-1" || sets[0].IncludeSpares || sets[1].Quantity != 2 {
+		t.Errorf("unexpected sets %+v", sets)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSetsCSV(&buf, sets); err != nil {
+		t.Fatal(err)
+	}
+	if want := "Set Number,Quantity,Includes Spares\n7018-1,1,False\n42102-1,2,True\n"; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
@@ -0,0 +1,109 @@
+package rebrickable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is matched by an *APIError with a status
+	// code of 404, e.g. an unknown set number.
+	ErrNotFound = errors.New("rebrickable: not found")
+
+	// ErrUnauthorized is matched by an *APIError with a status
+	// code of 401 or 403, e.g. an invalid API key or user token.
+	ErrUnauthorized = errors.New("rebrickable: unauthorized")
+
+	// ErrRateLimited is matched by an *APIError with a status
+	// code of 429, returned when the API key has been throttled.
+	ErrRateLimited = errors.New("rebrickable: rate limited")
+
+	// ErrUnsupported is wrapped by the errors of a Catalog which
+	// cannot answer a query, such as an option it has no data for.
+	ErrUnsupported = errors.New("rebrickable: unsupported")
+)
+
+// APIError is returned when the API responds with a non 2xx status
+// code. It can be matched against ErrNotFound, ErrUnauthorized and
+// ErrRateLimited using errors.Is.
+type APIError struct {
+	StatusCode int
+	Detail     string
+	Method     string
+	Endpoint   string
+
+	// RetryAfter is how long the API asked to wait before
+	// retrying the request, or zero if it did not say.
+	RetryAfter time.Duration
+}
+
+func newAPIError(req *http.Request, res *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		Endpoint:   redactUserToken(req.URL.Path),
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+	}
+
+	// try and decode error msg
+	var body struct {
+		Detail string `json:"detail"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err == nil {
+		e.Detail = body.Detail
+	}
+
+	return e
+}
+
+func (e *APIError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("rebrickable: %v %v: %v %v", e.Method, e.Endpoint, e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("rebrickable: %v %v: %v %v", e.Method, e.Endpoint, e.StatusCode, e.Detail)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// redactUserToken replaces the user token in the path of a users
+// endpoint with a placeholder, so it isn't leaked through errors.
+func redactUserToken(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == "users" && segments[i+1] != "" && segments[i+1] != "_token" {
+			segments[i+1] = "{user_token}"
+			break
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which
+// is either a number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+package rebrickable
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Run("NotFound", func(t *testing.T) {
+		globalMock.mockResponse(404, []byte(`{"detail": "Not found."}`), func() {
+			_, err := client.Set(ctx, "0000-1")
+			if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrUnauthorized) {
+				t.Errorf("expected ErrNotFound, got: %v", err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got: %T", err)
+			}
+			if apiErr.Detail != "Not found." || apiErr.Method != "GET" || apiErr.Endpoint != "/api/v3/lego/sets/0000-1" {
+				t.Errorf("unexpected error: %+v", apiErr)
+			}
+		})
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		globalMock.mockResponse(401, []byte(`{"detail": "Invalid token."}`), func() {
+			if _, err := client.Colors(ctx); !errors.Is(err, ErrUnauthorized) {
+				t.Errorf("expected ErrUnauthorized, got: %v", err)
+			}
+		})
+	})
+	t.Run("UserToken", func(t *testing.T) {
+		globalMock.mockResponse(404, []byte(`{"detail": "Not found."}`), func() {
+			_, err := user.SetList(ctx, 1)
+			if err == nil || strings.Contains(err.Error(), "arandomtoken") {
+				t.Errorf("expected redacted error, got: %v", err)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.Endpoint != "/api/v3/users/{user_token}/setlists/1/" {
+				t.Errorf("unexpected error: %+v", apiErr)
+			}
+		})
+	})
+	t.Run("RateLimited", func(t *testing.T) {
+		globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+			res := mockClientResponse(429, `{"detail": "Request was throttled."}`)
+			res.Header.Set("Retry-After", "3")
+			return res, nil
+		}
+		defer func() { globalMock.mockDo = mockResponse }()
+
+		err := user.DeletePartList(ctx, 1)
+		var apiErr *APIError
+		if !errors.Is(err, ErrRateLimited) || !errors.As(err, &apiErr) {
+			t.Fatalf("expected ErrRateLimited, got: %v", err)
+		}
+		if apiErr.RetryAfter != 3*time.Second || apiErr.Method != "DELETE" {
+			t.Errorf("unexpected error: %+v", apiErr)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	for v, expected := range map[string]time.Duration{
+		"":                              0,
+		"5":                             5 * time.Second,
+		"Wed, 01 Sep 2021 12:00:10 GMT": 10 * time.Second,
+		"Wed, 01 Sep 2021 11:00:00 GMT": 0,
+		"soon":                          0,
+	} {
+		if d := parseRetryAfter(v, now); d != expected {
+			t.Errorf("parseRetryAfter(%q): expected %v, got %v", v, expected, d)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package rebrickable
+
+import "context"
+
+// External systems known to Rebrickable, as used by ExternalIDs and
+// Translator. Brickset only has IDs for parts.
+const (
+	SystemBrickLink = "BrickLink"
+	SystemBrickOwl  = "BrickOwl"
+	SystemBrickset  = "Brickset"
+	SystemLDraw     = "LDraw"
+	SystemLEGO      = "LEGO"
+	SystemPeeron    = "Peeron"
+)
+
+// ExternalIDs maps the name of each external system to the IDs
+// a Color is known by in that system.
+type ExternalIDs map[string]ExternalID
+
+// ExternalID is the IDs of a Color in an external system, with the
+// names used for it by the ID at the same index. Systems without
+// numeric IDs, such as Peeron, have nil IDs and only names.
+type ExternalID struct {
+	ExtIds    []*int     `json:"ext_ids"`
+	ExtDescrs [][]string `json:"ext_descrs"`
+}
+
+// ID returns the first ID of the system, and whether it has one.
+func (e ExternalIDs) ID(system string) (int, bool) {
+	if ids := e.IDs(system); len(ids) > 0 {
+		return ids[0], true
+	}
+	return 0, false
+}
+
+// IDs returns all the non-nil IDs of the system.
+func (e ExternalIDs) IDs(system string) []int {
+	var ids []int
+	for _, id := range e[system].ExtIds {
+		if id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	return ids
+}
+
+// BrickLinkID returns the BrickLink ID of the Color.
+func (c Color) BrickLinkID() (int, bool) {
+	return c.ExternalIds.ID(SystemBrickLink)
+}
+
+// BrickOwlID returns the BrickOwl ID of the Color.
+func (c Color) BrickOwlID() (int, bool) {
+	return c.ExternalIds.ID(SystemBrickOwl)
+}
+
+// LDrawCode returns the LDraw colour code of the Color.
+func (c Color) LDrawCode() (int, bool) {
+	return c.ExternalIds.ID(SystemLDraw)
+}
+
+// LEGOID returns the LEGO material ID of the Color.
+func (c Color) LEGOID() (int, bool) {
+	return c.ExternalIds.ID(SystemLEGO)
+}
+
+// ColorIndex is an index of Color by their Rebrickable and external
+// IDs.
+type ColorIndex struct {
+	ids    map[int]Color
+	colors map[string]map[int]Color
+}
+
+// NewColorIndex returns a ColorIndex of colors. Where an external ID
+// is shared by several colors, the first of colors is used.
+func NewColorIndex(colors []Color) *ColorIndex {
+	index := &ColorIndex{make(map[int]Color), make(map[string]map[int]Color)}
+	for _, color := range colors {
+		index.ids[color.ID] = color
+		for system := range color.ExternalIds {
+			if index.colors[system] == nil {
+				index.colors[system] = make(map[int]Color)
+			}
+			for _, id := range color.ExternalIds.IDs(system) {
+				if _, ok := index.colors[system][id]; !ok {
+					index.colors[system][id] = color
+				}
+			}
+		}
+	}
+	return index
+}
+
+// LoadColorIndex returns a ColorIndex of every Color in catalog,
+// fetching as many pages as needed.
+func LoadColorIndex(ctx context.Context, catalog Catalog) (*ColorIndex, error) {
+	var colors []Color
+	err := eachPage(func(opts ...RequestOption) (int, error) {
+		results, err := catalog.Colors(ctx, opts...)
+		colors = append(colors, results...)
+		return len(results), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewColorIndex(colors), nil
+}
+
+// ColorByID returns the Color with the Rebrickable ID id, and whether
+// there is one.
+func (i *ColorIndex) ColorByID(id int) (Color, bool) {
+	color, ok := i.ids[id]
+	return color, ok
+}
+
+// ColorByExternalID returns the Color with the ID id in system, and
+// whether there is one.
+func (i *ColorIndex) ColorByExternalID(system string, id int) (Color, bool) {
+	color, ok := i.colors[system][id]
+	return color, ok
+}
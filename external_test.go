@@ -0,0 +1,37 @@
+package rebrickable
+
+import "testing"
+
+func TestExternalIDs(t *testing.T) {
+	color, err := client.Color(ctx, 212)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id, ok := color.BrickLinkID(); !ok || id != 105 {
+		t.Errorf("expected BrickLink ID 105, got %v", id)
+	}
+	if code, ok := color.LDrawCode(); !ok || code != 212 {
+		t.Errorf("expected LDraw code 212, got %v", code)
+	}
+	if _, ok := color.ExternalIds.ID(SystemPeeron); ok {
+		t.Error("expected no Peeron ID")
+	}
+	peeron := color.ExternalIds[SystemPeeron]
+	if len(peeron.ExtIds) != 2 || peeron.ExtIds[0] != nil || len(peeron.ExtDescrs) != 2 || peeron.ExtDescrs[0][0] != "ltroyalblue" {
+		t.Errorf("unexpected Peeron IDs %+v", peeron)
+	}
+
+	t.Run("ColorIndex", func(t *testing.T) {
+		index := NewColorIndex([]Color{color})
+		if c, ok := index.ColorByExternalID(SystemBrickOwl, 42); !ok || c.ID != 212 {
+			t.Errorf("expected color 212, got %+v", c)
+		}
+		if _, ok := index.ColorByExternalID(SystemBrickLink, 212); ok {
+			t.Error("expected no color")
+		}
+		if c, ok := index.ColorByID(212); !ok || c.Name != color.Name {
+			t.Errorf("expected color 212, got %+v", c)
+		}
+	})
+}
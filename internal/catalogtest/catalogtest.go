@@ -0,0 +1,140 @@
+// Package catalogtest provides an in-memory rebrickable.Catalog for
+// the tests of the packages built on it.
+package catalogtest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+// Catalog answers Colors, Element, Parts and Part from memory, any
+// other method panics. Colors, and Parts without an external ID
+// filter, are paginated like the API, answering ErrNotFound for a
+// page past the end.
+type Catalog struct {
+	rebrickable.Catalog
+
+	colors   []rebrickable.Color
+	parts    []rebrickable.Part
+	elements map[string]rebrickable.Element
+
+	// Requests counts the calls made to the Catalog.
+	Requests int
+}
+
+// New returns a Catalog of colors and parts.
+func New(colors []rebrickable.Color, parts []rebrickable.Part) *Catalog {
+	return &Catalog{colors: colors, parts: parts, elements: make(map[string]rebrickable.Element)}
+}
+
+// AddElement adds element to the Catalog.
+func (c *Catalog) AddElement(element rebrickable.Element) {
+	c.elements[element.ElementID] = element
+}
+
+// Color returns a Color with the ID id, known by the ID extID in
+// system.
+func Color(id int, system string, extID int) rebrickable.Color {
+	return rebrickable.Color{
+		ID:          id,
+		ExternalIds: rebrickable.ExternalIDs{system: {ExtIds: []*int{&extID}}},
+	}
+}
+
+func (c *Catalog) Colors(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Color, error) {
+	c.Requests++
+	start, end, err := page(query(opts), len(c.colors))
+	if err != nil {
+		return nil, err
+	}
+	return c.colors[start:end], nil
+}
+
+func (c *Catalog) Element(ctx context.Context, id string) (rebrickable.Element, error) {
+	c.Requests++
+	element, ok := c.elements[id]
+	if !ok {
+		return element, rebrickable.ErrNotFound
+	}
+	return element, nil
+}
+
+func (c *Catalog) Parts(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Part, error) {
+	c.Requests++
+	q := query(opts)
+
+	filters := map[string]func(rebrickable.Part) []string{
+		"bricklink_id": func(p rebrickable.Part) []string { return p.ExternalIds.BrickLink },
+		"brickowl_id":  func(p rebrickable.Part) []string { return p.ExternalIds.BrickOwl },
+		"ldraw_id":     func(p rebrickable.Part) []string { return p.ExternalIds.LDraw },
+		"lego_id":      func(p rebrickable.Part) []string { return p.ExternalIds.LEGO },
+	}
+	for param, ids := range filters {
+		id := q.Get(param)
+		if id == "" {
+			continue
+		}
+		var parts []rebrickable.Part
+		for _, part := range c.parts {
+			for _, ext := range ids(part) {
+				if ext == id {
+					parts = append(parts, part)
+				}
+			}
+		}
+		return parts, nil
+	}
+
+	start, end, err := page(q, len(c.parts))
+	if err != nil {
+		return nil, err
+	}
+	return c.parts[start:end], nil
+}
+
+func (c *Catalog) Part(ctx context.Context, partNumber string) (rebrickable.Part, error) {
+	c.Requests++
+	for _, part := range c.parts {
+		if part.PartNum == partNumber {
+			return part, nil
+		}
+	}
+	return rebrickable.Part{}, rebrickable.ErrNotFound
+}
+
+// query returns the query parameters set by opts, by applying them
+// to a request which is never sent.
+func query(opts []rebrickable.RequestOption) url.Values {
+	req := &http.Request{URL: &url.URL{}}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return req.URL.Query()
+}
+
+// page returns the bounds of the page of n results requested by q,
+// which is every result if no page size was requested.
+func page(q url.Values, n int) (start, end int, err error) {
+	size, err := strconv.Atoi(q.Get("page_size"))
+	if err != nil {
+		return 0, n, nil
+	}
+	number, err := strconv.Atoi(q.Get("page"))
+	if err != nil {
+		number = 1
+	}
+
+	start = (number - 1) * size
+	if start >= n && number > 1 {
+		return 0, 0, rebrickable.ErrNotFound
+	}
+	end = start + size
+	if end > n {
+		end = n
+	}
+	return start, end, nil
+}
@@ -0,0 +1,119 @@
+package ldd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+type partColor struct {
+	partNum string
+	colorID int
+}
+
+// resolver looks up the parts and colors of bricks in a catalog,
+// remembering the result of each lookup.
+type resolver struct {
+	catalog    rebrickable.Catalog
+	colors     *rebrickable.ColorIndex
+	translator *rebrickable.Translator
+	elements   map[string]*rebrickable.InventoryPart
+}
+
+// Inventory maps bricks to Rebrickable parts and colors through
+// catalog, combining the quantities of bricks which map to the same
+// part and color. Bricks with an element ID are looked up as elements,
+// falling back to their design ID and material as the LEGO IDs of a
+// part and color. Bricks which cannot be mapped are returned as
+// unresolved.
+func Inventory(ctx context.Context, catalog rebrickable.Catalog, bricks []Brick) ([]rebrickable.InventoryPart, []Brick, error) {
+	colors, err := rebrickable.LoadColorIndex(ctx, catalog)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := &resolver{
+		catalog:    catalog,
+		colors:     colors,
+		translator: rebrickable.NewTranslator(catalog),
+		elements:   make(map[string]*rebrickable.InventoryPart),
+	}
+
+	var (
+		inventory  []rebrickable.InventoryPart
+		unresolved []Brick
+	)
+	index := make(map[partColor]int)
+	for _, brick := range bricks {
+		part, err := r.resolve(ctx, brick)
+		if err != nil {
+			return nil, nil, err
+		}
+		if part == nil {
+			unresolved = append(unresolved, brick)
+			continue
+		}
+
+		key := partColor{part.Part.PartNum, part.Color.ID}
+		if i, ok := index[key]; ok {
+			inventory[i].Quantity += brick.Quantity
+			continue
+		}
+		index[key] = len(inventory)
+		p := *part
+		p.Quantity = brick.Quantity
+		inventory = append(inventory, p)
+	}
+
+	return inventory, unresolved, nil
+}
+
+// resolve returns the part and color of brick, or nil if either
+// is unknown.
+func (r *resolver) resolve(ctx context.Context, brick Brick) (*rebrickable.InventoryPart, error) {
+	if brick.ElementID != "" {
+		part, err := r.element(ctx, brick.ElementID)
+		if part != nil || err != nil {
+			return part, err
+		}
+	}
+
+	translation, err := r.translator.Translate(ctx, rebrickable.SystemLEGO, brick.DesignID)
+	if !translation.Found() || err != nil {
+		return nil, err
+	}
+	color, ok := r.colors.ColorByExternalID(rebrickable.SystemLEGO, brick.Material)
+	if !ok {
+		return nil, nil
+	}
+	return &rebrickable.InventoryPart{Part: translation.Part(), Color: color}, nil
+}
+
+// element returns the part and color of the element id, or nil
+// if it is unknown.
+func (r *resolver) element(ctx context.Context, id string) (*rebrickable.InventoryPart, error) {
+	if part, ok := r.elements[id]; ok {
+		return part, nil
+	}
+
+	element, err := r.catalog.Element(ctx, id)
+	if errors.Is(err, rebrickable.ErrNotFound) {
+		r.elements[id] = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	color, ok := r.colors.ColorByID(element.Color.ID)
+	if !ok {
+		r.elements[id] = nil
+		return nil, nil
+	}
+	part := &rebrickable.InventoryPart{
+		Part:      element.Part,
+		Color:     color,
+		ElementID: element.ElementID,
+	}
+	r.elements[id] = part
+	return part, nil
+}
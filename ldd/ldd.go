@@ -0,0 +1,155 @@
+// Package ldd reads LEGO Digital Designer models (.lxf and .lxfml
+// files) and converts them to Rebrickable inventories, mapping LEGO
+// design IDs, material IDs and element IDs through the LEGO external
+// IDs known to Rebrickable.
+package ldd
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrNoModel is returned when a .lxf file does not contain a model.
+var ErrNoModel = errors.New("ldd: no model in file")
+
+// Brick is a quantity of a LEGO part in a material, as used by LDD.
+type Brick struct {
+	DesignID string
+	Material int
+
+	// ElementID is the LEGO element ID of the brick, if known.
+	ElementID string
+	Quantity  int
+}
+
+// lxfml is the subset of the LXFML format needed to list the bricks
+// of a model. Bricks made of several parts, such as minifig legs, have
+// a part for each, while older versions of the format set the material
+// on the brick itself.
+type lxfml struct {
+	Bricks []struct {
+		DesignID   string `xml:"designID,attr"`
+		ItemNos    string `xml:"itemNos,attr"`
+		MaterialID string `xml:"materialID,attr"`
+		Materials  string `xml:"materials,attr"`
+		Parts      []struct {
+			DesignID  string `xml:"designID,attr"`
+			Materials string `xml:"materials,attr"`
+		} `xml:"Part"`
+	} `xml:"Bricks>Brick"`
+}
+
+// ParseLXFML reads the bricks of the LXFML model r, combining the
+// quantities of each brick in each material. Bricks made of several
+// parts are listed as each of their parts.
+func ParseLXFML(r io.Reader) ([]Brick, error) {
+	var doc lxfml
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var bricks []Brick
+	index := make(map[Brick]int)
+	add := func(b Brick) {
+		if i, ok := index[b]; ok {
+			bricks[i].Quantity++
+			return
+		}
+		index[b] = len(bricks)
+		b.Quantity = 1
+		bricks = append(bricks, b)
+	}
+
+	for _, brick := range doc.Bricks {
+		if len(brick.Parts) == 0 {
+			materials := brick.MaterialID
+			if materials == "" {
+				materials = brick.Materials
+			}
+			material, err := parseMaterial(materials)
+			if err != nil {
+				return nil, err
+			}
+			add(Brick{DesignID: brick.DesignID, Material: material, ElementID: brick.ItemNos})
+			continue
+		}
+
+		for _, part := range brick.Parts {
+			material, err := parseMaterial(part.Materials)
+			if err != nil {
+				return nil, err
+			}
+			b := Brick{DesignID: part.DesignID, Material: material}
+			if len(brick.Parts) == 1 {
+				// the element ID of a brick made of several parts
+				// is that of the whole assembly
+				b.ElementID = brick.ItemNos
+			}
+			add(b)
+		}
+	}
+
+	return bricks, nil
+}
+
+// ReadLXF reads the bricks of the .lxf file r of size bytes, which is
+// a zip archive containing an LXFML model and a thumbnail.
+func ReadLXF(r io.ReaderAt, size int64) ([]Brick, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range zr.File {
+		if !strings.EqualFold(filepath.Ext(f.Name), ".lxfml") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ParseLXFML(rc)
+	}
+
+	return nil, ErrNoModel
+}
+
+// Open reads the bricks of the .lxf or .lxfml file at path.
+func Open(path string) ([]Brick, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".lxfml") {
+		return ParseLXFML(f)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return ReadLXF(f, info.Size())
+}
+
+// parseMaterial parses the main material of a brick, which is the
+// first of a comma separated list of materials.
+func parseMaterial(s string) (int, error) {
+	if i := strings.Index(s, ","); i >= 0 {
+		s = s[:i]
+	}
+	material, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("ldd: invalid material %q", s)
+	}
+	return material, nil
+}
@@ -0,0 +1,122 @@
+package ldd
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/thelolagemann/go-rebrickable"
+	"github.com/thelolagemann/go-rebrickable/internal/catalogtest"
+)
+
+var ctx = context.Background()
+
+const testLXFML = `<?xml version="1.0" encoding="UTF-8" standalone="no" ?>
+<LXFML versionMajor="5" versionMinor="0" name="Test">
+  <Meta>
+    <Application name="LEGO Digital Designer" versionMajor="4" versionMinor="3"/>
+  </Meta>
+  <Bricks cameraRef="0">
+    <Brick refID="0" designID="3001" itemNos="300121">
+      <Part refID="0" designID="3001" materials="21,0">
+        <Bone refID="0" transformation="1,0,0,0,1,0,0,0,1,0,0,0"/>
+      </Part>
+    </Brick>
+    <Brick refID="1" designID="3001" itemNos="300121">
+      <Part refID="1" designID="3001" materials="21">
+        <Bone refID="1" transformation="1,0,0,0,1,0,0,0,1,0,0,0"/>
+      </Part>
+    </Brick>
+    <Brick refID="2" designID="3001">
+      <Part refID="2" designID="3001" materials="21"/>
+    </Brick>
+    <Brick refID="3" designID="73200" itemNos="4275606">
+      <Part refID="3" designID="3815" materials="1"/>
+      <Part refID="4" designID="3816" materials="26"/>
+      <Part refID="5" designID="3817" materials="26"/>
+    </Brick>
+    <Brick refID="6" designID="3023" materialID="1"/>
+  </Bricks>
+</LXFML>
+`
+
+func TestParseLXFML(t *testing.T) {
+	bricks, err := ParseLXFML(bytes.NewReader([]byte(testLXFML)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Brick{
+		{"3001", 21, "300121", 2},
+		{"3001", 21, "", 1},
+		{"3815", 1, "", 1},
+		{"3816", 26, "", 1},
+		{"3817", 26, "", 1},
+		{"3023", 1, "", 1},
+	}
+	if len(bricks) != len(want) {
+		t.Fatalf("expected %v bricks, got %+v", len(want), bricks)
+	}
+	for i := range want {
+		if bricks[i] != want[i] {
+			t.Errorf("expected %+v, got %+v", want[i], bricks[i])
+		}
+	}
+}
+
+func TestReadLXF(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	zw.Create("IMAGE100.PNG")
+	w, _ := zw.Create("IMAGE100.LXFML")
+	w.Write([]byte(testLXFML))
+	zw.Close()
+
+	bricks, err := ReadLXF(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bricks) != 6 {
+		t.Errorf("expected 6 bricks, got %+v", bricks)
+	}
+}
+
+func TestInventory(t *testing.T) {
+	colors := []rebrickable.Color{
+		catalogtest.Color(4, rebrickable.SystemLEGO, 21),
+		catalogtest.Color(1, rebrickable.SystemLEGO, 23),
+		catalogtest.Color(15, rebrickable.SystemLEGO, 1),
+	}
+	plate := rebrickable.Part{PartNum: "3023"}
+	plate.ExternalIds.LEGO = []string{"3023", "6225"}
+	catalog := catalogtest.New(colors, []rebrickable.Part{{PartNum: "3001"}, plate})
+	catalog.AddElement(rebrickable.Element{
+		ElementID: "300121",
+		Part:      rebrickable.Part{PartNum: "3001"},
+		Color:     rebrickable.Color{ID: 4},
+	})
+
+	bricks := []Brick{
+		{"3001", 21, "300121", 2},
+		{"3001", 21, "", 1},
+		{"3023", 1, "", 1},
+		{"3023", 26, "", 1},
+		{"3815", 1, "", 1},
+	}
+	inventory, unresolved, err := Inventory(ctx, catalog, bricks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inventory) != 2 {
+		t.Fatalf("expected 2 parts, got %+v", inventory)
+	}
+	if p := inventory[0]; p.Part.PartNum != "3001" || p.Color.ID != 4 || p.Quantity != 3 || p.ElementID != "300121" {
+		t.Errorf("unexpected part %+v", p)
+	}
+	if p := inventory[1]; p.Part.PartNum != "3023" || p.Color.ID != 15 || p.Quantity != 1 {
+		t.Errorf("unexpected part %+v", p)
+	}
+	if len(unresolved) != 2 || unresolved[0].Material != 26 || unresolved[1].DesignID != "3815" {
+		t.Errorf("unexpected unresolved %+v", unresolved)
+	}
+}
@@ -0,0 +1,53 @@
+package ldraw
+
+import (
+	"context"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+type partColour struct {
+	partNum string
+	colorID int
+}
+
+// Inventory maps parts to Rebrickable parts and colours through the
+// LDraw external IDs known to catalog, combining the quantities of
+// parts which map to the same Rebrickable part and colour. Parts are
+// translated from their LDraw ID with a Translator. Parts whose part
+// or colour cannot be mapped are returned as unmapped.
+func Inventory(ctx context.Context, catalog rebrickable.Catalog, parts []Part) ([]rebrickable.InventoryPart, []Part, error) {
+	colours, err := rebrickable.LoadColorIndex(ctx, catalog)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		inventory []rebrickable.InventoryPart
+		unmapped  []Part
+	)
+	translator := rebrickable.NewTranslator(catalog)
+	index := make(map[partColour]int)
+	for _, p := range parts {
+		translation, err := translator.Translate(ctx, rebrickable.SystemLDraw, p.ID())
+		if err != nil {
+			return nil, nil, err
+		}
+		colour, ok := colours.ColorByExternalID(rebrickable.SystemLDraw, p.Colour)
+		if !translation.Found() || !ok {
+			unmapped = append(unmapped, p)
+			continue
+		}
+
+		part := translation.Part()
+		key := partColour{part.PartNum, colour.ID}
+		if i, ok := index[key]; ok {
+			inventory[i].Quantity += p.Quantity
+			continue
+		}
+		index[key] = len(inventory)
+		inventory = append(inventory, rebrickable.InventoryPart{Part: part, Color: colour, Quantity: p.Quantity})
+	}
+
+	return inventory, unmapped, nil
+}
@@ -0,0 +1,138 @@
+package ldraw
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/thelolagemann/go-rebrickable"
+	"github.com/thelolagemann/go-rebrickable/internal/catalogtest"
+)
+
+var ctx = context.Background()
+
+const testModel = `0 FILE main.ldr
+0 Main model
+0 Name: main.ldr
+1 4 0 0 0 1 0 0 0 1 0 0 0 1 3001.dat
+1 1 0 -24 0 1 0 0 0 1 0 0 0 1 Wing.ldr
+1 14 0 -48 0 1 0 0 0 1 0 0 0 1 wing.ldr
+1 16 0 -72 0 1 0 0 0 1 0 0 0 1 3023.DAT
+0 NOFILE
+0 FILE wing.ldr
+1 16 0 0 0 1 0 0 0 1 0 0 0 1 3023.dat
+1 16 0 -8 0 1 0 0 0 1 0 0 0 1 3023.dat
+1 0 0 -16 0 1 0 0 0 1 0 0 0 1 parts\3001.dat
+1 16 0 -24 0 1 0 0 0 1 0 0 0 1 tail.ldr
+0 NOFILE
+0 FILE tail.ldr
+1 16 0 0 0 1 0 0 0 1 0 0 0 1 3626cp01.dat
+1 4 0 0 0 1 0 0 0 1 0 0 0 1 99999.dat
+0 NOFILE
+0 !DATA texture.png
+0 !: iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mP8z8BQDwAEhQGAhKmMIQAAAABJRU5ErkJggg==
+`
+
+func TestModel_Parts(t *testing.T) {
+	m, err := Parse(strings.NewReader(testModel))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "main.ldr" {
+		t.Errorf("unexpected name %q", m.Name)
+	}
+
+	parts, err := m.Parts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Part{
+		{"3001.dat", 4, 1},
+		{"3023.dat", 1, 2},
+		{"parts/3001.dat", 0, 2},
+		{"3626cp01.dat", 1, 1},
+		{"99999.dat", 4, 2},
+		{"3023.dat", 14, 2},
+		{"3626cp01.dat", 14, 1},
+		{"3023.dat", MainColour, 1},
+	}
+	if len(parts) != len(want) {
+		t.Fatalf("expected %v parts, got %+v", len(want), parts)
+	}
+	for i := range want {
+		if parts[i] != want[i] {
+			t.Errorf("expected %+v, got %+v", want[i], parts[i])
+		}
+	}
+
+	t.Run("Cycle", func(t *testing.T) {
+		m, err := Parse(strings.NewReader("0 FILE a.ldr\n1 16 0 0 0 1 0 0 0 1 0 0 0 1 b.ldr\n0 FILE b.ldr\n1 16 0 0 0 1 0 0 0 1 0 0 0 1 a.ldr\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := m.Parts(); err == nil {
+			t.Error("expected cycle error")
+		}
+	})
+}
+
+func TestPart_ID(t *testing.T) {
+	for file, id := range map[string]string{
+		"3001.dat":           "3001",
+		"parts/3001.dat":     "3001",
+		`PARTS\3626cp01.DAT`: "3626cp01",
+		`s\3001s01.dat`:      "3001s01",
+	} {
+		if got := (Part{File: file}).ID(); got != id {
+			t.Errorf("expected %q for %q, got %q", id, file, got)
+		}
+	}
+}
+
+func TestParseColour(t *testing.T) {
+	for s, colour := range map[string]int{"4": 4, "010": 10, "0x2FF0000": 0x2FF0000} {
+		if got, err := parseColour(s); err != nil || got != colour {
+			t.Errorf("expected %v for %q, got %v: %v", colour, s, got, err)
+		}
+	}
+	for _, s := range []string{"0x10", "0x3FF0000", "red"} {
+		if _, err := parseColour(s); err == nil {
+			t.Errorf("expected %q to be rejected", s)
+		}
+	}
+}
+
+func TestInventory(t *testing.T) {
+	var colours []rebrickable.Color
+	for _, id := range []int{4, 1, 0} {
+		colours = append(colours, catalogtest.Color(id, rebrickable.SystemLDraw, id))
+	}
+	head := rebrickable.Part{PartNum: "3626cpr0001"}
+	head.ExternalIds.LDraw = []string{"3626cp01"}
+	catalog := catalogtest.New(colours, []rebrickable.Part{{PartNum: "3001"}, {PartNum: "3023"}, head})
+
+	parts := []Part{
+		{"3001.dat", 4, 1},
+		{"parts/3001.dat", 4, 2},
+		{"3626cp01.dat", 1, 1},
+		{"3023.dat", 14, 2},
+		{"99999.dat", 4, 2},
+	}
+	inventory, unmapped, err := Inventory(ctx, catalog, parts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inventory) != 2 {
+		t.Fatalf("expected 2 parts, got %+v", inventory)
+	}
+	// parts/3001.dat is the same part as 3001.dat
+	if p := inventory[0]; p.Part.PartNum != "3001" || p.Color.ID != 4 || p.Quantity != 3 {
+		t.Errorf("unexpected part %+v", p)
+	}
+	if p := inventory[1]; p.Part.PartNum != "3626cpr0001" || p.Color.ID != 1 {
+		t.Errorf("unexpected part %+v", p)
+	}
+	if len(unmapped) != 2 {
+		t.Errorf("unexpected unmapped %+v", unmapped)
+	}
+}
@@ -0,0 +1,200 @@
+// Package ldraw reads LDraw models (.ldr and .mpd files) and converts
+// them to Rebrickable inventories, mapping LDraw parts and colours
+// through the LDraw external IDs known to Rebrickable.
+package ldraw
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Colours with a special meaning when referencing a part or model.
+const (
+	// MainColour is replaced by the colour of the line
+	// referencing the file it is used in.
+	MainColour = 16
+
+	// EdgeColour is the complementary edge colour.
+	EdgeColour = 24
+)
+
+// Part is a quantity of an LDraw part in a colour.
+type Part struct {
+	// File is the name of the part file, e.g. 3001.dat.
+	File     string
+	Colour   int
+	Quantity int
+}
+
+// ID returns the LDraw ID of the part, which is its file name
+// without any directory or the .dat extension.
+func (p Part) ID() string {
+	return strings.TrimSuffix(path.Base(normaliseName(p.File)), ".dat")
+}
+
+// Model is a parsed LDraw model, with any submodels it contains.
+type Model struct {
+	// Name is the name of the main model, which is the first file of
+	// a multi-part document, or empty for a plain .ldr file.
+	Name string
+
+	files map[string][]reference
+}
+
+// reference is a type 1 line, referencing a part or submodel.
+type reference struct {
+	colour int
+	file   string
+}
+
+// Parse reads an LDraw model from r, which may be a single model or
+// a multi-part document (MPD) containing submodels.
+func Parse(r io.Reader) (*Model, error) {
+	m := &Model{files: make(map[string][]reference)}
+
+	current, skip, started := "", false, false
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for line := 1; s.Scan(); line++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "0":
+			if len(fields) < 2 {
+				continue
+			}
+			switch strings.ToUpper(fields[1]) {
+			case "FILE":
+				if len(fields) < 3 {
+					continue
+				}
+				current, skip = normaliseName(strings.Join(fields[2:], " ")), false
+				if !started {
+					// the first file of an MPD is the main model, any
+					// lines before it are discarded
+					m.Name, started = current, true
+					delete(m.files, "")
+				}
+				if _, ok := m.files[current]; !ok {
+					m.files[current] = nil
+				}
+			case "!DATA":
+				// embedded data, such as textures
+				skip = true
+			case "NOFILE":
+				skip = true
+			}
+		case "1":
+			if skip {
+				continue
+			}
+			if len(fields) < 15 {
+				return nil, fmt.Errorf("ldraw: line %d: expected 15 fields, got %v", line, len(fields))
+			}
+			colour, err := parseColour(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("ldraw: line %d: invalid colour %q", line, fields[1])
+			}
+			m.files[current] = append(m.files[current], reference{colour, strings.Join(fields[14:], " ")})
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ParseFile reads an LDraw model from the file at path.
+func ParseFile(path string) (*Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parts returns the parts used by the main model, including those
+// of its submodels, combining the quantities of each part in each
+// colour. Parts inheriting MainColour from the main model are
+// returned with MainColour.
+func (m *Model) Parts() ([]Part, error) {
+	return m.flatten(m.Name, make(map[string]bool), make(map[string][]Part))
+}
+
+// flatten returns the parts of the file name, keeping MainColour
+// where it is inherited. Submodels are flattened once, and cycles
+// of submodels referencing themselves are an error.
+func (m *Model) flatten(name string, visiting map[string]bool, memo map[string][]Part) ([]Part, error) {
+	if parts, ok := memo[name]; ok {
+		return parts, nil
+	}
+	if visiting[name] {
+		return nil, fmt.Errorf("ldraw: submodel %q references itself", name)
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	var parts []Part
+	index := make(map[Part]int)
+	add := func(p Part) {
+		key := Part{File: p.File, Colour: p.Colour}
+		if i, ok := index[key]; ok {
+			parts[i].Quantity += p.Quantity
+			return
+		}
+		index[key] = len(parts)
+		parts = append(parts, p)
+	}
+
+	for _, ref := range m.files[name] {
+		file := normaliseName(ref.file)
+		if _, ok := m.files[file]; !ok {
+			add(Part{File: file, Colour: ref.colour, Quantity: 1})
+			continue
+		}
+
+		sub, err := m.flatten(file, visiting, memo)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range sub {
+			if p.Colour == MainColour {
+				p.Colour = ref.colour
+			}
+			add(p)
+		}
+	}
+
+	memo[name] = parts
+	return parts, nil
+}
+
+// normaliseName normalises a file name as LDraw does, which is
+// case insensitive and may use either path separator.
+func normaliseName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+}
+
+// parseColour parses a decimal LDraw colour code, which may also be
+// a direct colour of the form 0x2RRGGBB, e.g. 0x2FF0000.
+func parseColour(s string) (int, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		if len(s) != 9 || s[2] != '2' {
+			return 0, fmt.Errorf("ldraw: invalid direct colour %q", s)
+		}
+		v, err := strconv.ParseInt(s[2:], 16, 32)
+		return int(v), err
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int(v), err
+}
@@ -1,6 +1,7 @@
 package rebrickable
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -10,100 +11,40 @@ func (c *Client) endpoint(endpoint string, a ...interface{}) string {
 }
 
 // Colors get a list of all Color.
-func (c *Client) Colors(opts ...RequestOption) (colors []Color, err error) {
-	err = c.get("lego/colors/", true, &colors, opts...)
+func (c *Client) Colors(ctx context.Context, opts ...RequestOption) (colors []Color, err error) {
+	err = c.get(ctx, "lego/colors/", true, &colors, opts...)
 	return
 }
 
+// ColorsPager returns a Pager over every page of Colors, each
+// page decoding into a []Color.
+func (c *Client) ColorsPager(opts ...RequestOption) *Pager {
+	return c.newPager("lego/colors/", opts...)
+}
+
 // Color get details about a specific Color.
-func (c *Client) Color(id int, opts ...RequestOption) (color Color, err error) {
-	err = c.get(c.endpoint("colors/%v", id), false, &color, opts...)
+func (c *Client) Color(ctx context.Context, id int, opts ...RequestOption) (color Color, err error) {
+	err = c.get(ctx, c.endpoint("colors/%v", id), false, &color, opts...)
 	return
 }
 
 type Color struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Rgb         string `json:"rgb"`
-	IsTrans     bool   `json:"is_trans"`
-	ExternalIds struct {
-		BrickLink struct {
-			ExtIds    []int      `json:"ext_ids"`
-			ExtDescrs [][]string `json:"ext_descrs"`
-		} `json:"BrickLink"`
-		BrickOwl struct {
-			ExtIds    []int      `json:"ext_ids"`
-			ExtDescrs [][]string `json:"ext_descrs"`
-		} `json:"BrickOwl"`
-		Lego struct {
-			ExtIds    []int      `json:"ext_ids"`
-			ExtDescrs [][]string `json:"ext_descrs"`
-		} `json:"LEGO"`
-		Peeron struct {
-			ExtIds    []interface{} `json:"ext_ids"`
-			ExtDescrs [][]string    `json:"ext_descrs"`
-		} `json:"Peeron"`
-		LDraw struct {
-			ExtIds    []int      `json:"ext_ids"`
-			ExtDescrs [][]string `json:"ext_descrs"`
-		} `json:"LDraw"`
-	} `json:"external_ids"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Rgb         string      `json:"rgb"`
+	IsTrans     bool        `json:"is_trans"`
+	ExternalIds ExternalIDs `json:"external_ids"`
 }
 
 // Element get details about a specific Element ID.
-func (c *Client) Element(id string) (element Element, err error) {
-	err = c.get(c.endpoint("elements/%v", id), false, &element)
+func (c *Client) Element(ctx context.Context, id string) (element Element, err error) {
+	err = c.get(ctx, c.endpoint("elements/%v", id), false, &element)
 	return
 }
 
 type Element struct {
-	Part struct {
-		PartNum     string        `json:"part_num"`
-		Name        string        `json:"name"`
-		PartCatID   int           `json:"part_cat_id"`
-		YearFrom    int           `json:"year_from"`
-		YearTo      int           `json:"year_to"`
-		PartURL     string        `json:"part_url"`
-		PartImgURL  string        `json:"part_img_url"`
-		Prints      []interface{} `json:"prints"`
-		Molds       []interface{} `json:"molds"`
-		Alternates  []interface{} `json:"alternates"`
-		ExternalIds struct {
-			BrickLink []string `json:"BrickLink"`
-			BrickOwl  []string `json:"BrickOwl"`
-			Brickset  []string `json:"Brickset"`
-			Lego      []string `json:"LEGO"`
-		} `json:"external_ids"`
-		PrintOf string `json:"print_of"`
-	} `json:"part"`
-	Color struct {
-		ID          int    `json:"id"`
-		Name        string `json:"name"`
-		Rgb         string `json:"rgb"`
-		IsTrans     bool   `json:"is_trans"`
-		ExternalIds struct {
-			BrickLink struct {
-				ExtIds    []int      `json:"ext_ids"`
-				ExtDescrs [][]string `json:"ext_descrs"`
-			} `json:"BrickLink"`
-			BrickOwl struct {
-				ExtIds    []int      `json:"ext_ids"`
-				ExtDescrs [][]string `json:"ext_descrs"`
-			} `json:"BrickOwl"`
-			Lego struct {
-				ExtIds    []int      `json:"ext_ids"`
-				ExtDescrs [][]string `json:"ext_descrs"`
-			} `json:"LEGO"`
-			Peeron struct {
-				ExtIds    []interface{} `json:"ext_ids"`
-				ExtDescrs [][]string    `json:"ext_descrs"`
-			} `json:"Peeron"`
-			LDraw struct {
-				ExtIds    []int      `json:"ext_ids"`
-				ExtDescrs [][]string `json:"ext_descrs"`
-			} `json:"LDraw"`
-		} `json:"external_ids"`
-	} `json:"color"`
+	Part          Part   `json:"part"`
+	Color         Color  `json:"color"`
 	ElementID     string `json:"element_id"`
 	DesignID      string `json:"design_id"`
 	ElementImgURL string `json:"element_img_url"`
@@ -111,29 +52,47 @@ type Element struct {
 }
 
 // Minifigs get a list of Minifig.
-func (c *Client) Minifigs(opts ...RequestOption) (minifigs []Minifig, err error) {
-	err = c.get("lego/minifigs", true, &minifigs, opts...)
+func (c *Client) Minifigs(ctx context.Context, opts ...RequestOption) (minifigs []Minifig, err error) {
+	err = c.get(ctx, "lego/minifigs", true, &minifigs, opts...)
 	return
 }
 
+// MinifigsPager returns a Pager over every page of Minifigs, each
+// page decoding into a []Minifig.
+func (c *Client) MinifigsPager(opts ...RequestOption) *Pager {
+	return c.newPager("lego/minifigs", opts...)
+}
+
 // Minifig get details for a specific Minifig.
-func (c *Client) Minifig(setNumber string) (minifig Minifig, err error) {
-	err = c.get(fmt.Sprintf("lego/minifigs/%v", setNumber), false, &minifig)
+func (c *Client) Minifig(ctx context.Context, setNumber string) (minifig Minifig, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/minifigs/%v", setNumber), false, &minifig)
 	return
 }
 
-// MinifigParts get a list of all inventory Part\s in this Minifig.
-func (c *Client) MinifigParts(setNumber string, opts ...RequestOption) (parts []Part, err error) {
-	err = c.get(fmt.Sprintf("lego/minifigs/%v/parts", setNumber), true, &parts, opts...)
+// MinifigParts get a list of all InventoryPart in this Minifig.
+func (c *Client) MinifigParts(ctx context.Context, setNumber string, opts ...RequestOption) (parts []InventoryPart, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/minifigs/%v/parts", setNumber), true, &parts, opts...)
 	return
 }
 
+// MinifigPartsPager returns a Pager over every page of MinifigParts, each
+// page decoding into a []InventoryPart.
+func (c *Client) MinifigPartsPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/minifigs/%v/parts", setNumber), opts...)
+}
+
 // MinifigSets get a list of Set a Minifig has appeared in.
-func (c *Client) MinifigSets(setNumber string, opts ...RequestOption) (sets []Set, err error) {
-	err = c.get(c.endpoint("minifigs/%v/sets", setNumber), true, &sets, opts...)
+func (c *Client) MinifigSets(ctx context.Context, setNumber string, opts ...RequestOption) (sets []Set, err error) {
+	err = c.get(ctx, c.endpoint("minifigs/%v/sets", setNumber), true, &sets, opts...)
 	return
 }
 
+// MinifigSetsPager returns a Pager over every page of MinifigSets, each
+// page decoding into a []Set.
+func (c *Client) MinifigSetsPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(c.endpoint("minifigs/%v/sets", setNumber), opts...)
+}
+
 type Minifig struct {
 	SetNum         string    `json:"set_num"`
 	Name           string    `json:"name"`
@@ -143,15 +102,51 @@ type Minifig struct {
 	LastModifiedDt time.Time `json:"last_modified_dt"`
 }
 
+// MOC get details for a specific MOC.
+func (c *Client) MOC(ctx context.Context, setNumber string) (moc MOC, err error) {
+	err = c.get(ctx, c.endpoint("mocs/%v", setNumber), false, &moc)
+	return
+}
+
+// MOCParts get a list of all InventoryPart in this MOC.
+func (c *Client) MOCParts(ctx context.Context, setNumber string, opts ...RequestOption) (parts []InventoryPart, err error) {
+	err = c.get(ctx, c.endpoint("mocs/%v/parts", setNumber), true, &parts, opts...)
+	return
+}
+
+// MOCPartsPager returns a Pager over every page of MOCParts, each
+// page decoding into a []InventoryPart.
+func (c *Client) MOCPartsPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(c.endpoint("mocs/%v/parts", setNumber), opts...)
+}
+
+type MOC struct {
+	SetNum       string `json:"set_num"`
+	Name         string `json:"name"`
+	Year         int    `json:"year"`
+	ThemeID      int    `json:"theme_id"`
+	NumParts     int    `json:"num_parts"`
+	MOCImgURL    string `json:"moc_img_url"`
+	MOCURL       string `json:"moc_url"`
+	DesignerName string `json:"designer_name"`
+	DesignerURL  string `json:"designer_url"`
+}
+
 // PartCategories get a list of all PartCategory.
-func (c *Client) PartCategories(opts ...RequestOption) (partCategories []PartCategory, err error) {
-	err = c.get(c.endpoint("part_categories"), true, &partCategories, opts...)
+func (c *Client) PartCategories(ctx context.Context, opts ...RequestOption) (partCategories []PartCategory, err error) {
+	err = c.get(ctx, c.endpoint("part_categories"), true, &partCategories, opts...)
 	return
 }
 
+// PartCategoriesPager returns a Pager over every page of PartCategories, each
+// page decoding into a []PartCategory.
+func (c *Client) PartCategoriesPager(opts ...RequestOption) *Pager {
+	return c.newPager(c.endpoint("part_categories"), opts...)
+}
+
 // PartCategory get details about a specific PartCategory.
-func (c *Client) PartCategory(id int, opts ...RequestOption) (partCategory PartCategory, err error) {
-	err = c.get(c.endpoint("part_categories/%v", id), false, &partCategory, opts...)
+func (c *Client) PartCategory(ctx context.Context, id int, opts ...RequestOption) (partCategory PartCategory, err error) {
+	err = c.get(ctx, c.endpoint("part_categories/%v", id), false, &partCategory, opts...)
 	return
 }
 
@@ -162,30 +157,45 @@ type PartCategory struct {
 }
 
 // Parts get a list of Part.
-func (c *Client) Parts(opts ...RequestOption) (parts []Part, err error) {
-	err = c.get("lego/parts", true, &parts, opts...)
+func (c *Client) Parts(ctx context.Context, opts ...RequestOption) (parts []Part, err error) {
+	err = c.get(ctx, "lego/parts", true, &parts, opts...)
 	return
 }
 
+// PartsPager returns a Pager over every page of Parts, each
+// page decoding into a []Part.
+func (c *Client) PartsPager(opts ...RequestOption) *Pager {
+	return c.newPager("lego/parts", opts...)
+}
+
 // Part get details about a specific Part.
-func (c *Client) Part(partNumber string) (part Part, err error) {
-	err = c.get(fmt.Sprintf("lego/parts/%v", partNumber), false, &part)
+func (c *Client) Part(ctx context.Context, partNumber string) (part Part, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/parts/%v", partNumber), false, &part)
 	return
 }
 
-// PartColors get a list of all Color a Part has appeared in.
-func (c *Client) PartColors(partNumber string, opts ...RequestOption) (colors []Color, err error) {
-	err = c.get(fmt.Sprintf("lego/parts/%v/colors", partNumber), true, &colors, opts...)
+// PartColors get a list of all PartColor a Part has appeared in.
+func (c *Client) PartColors(ctx context.Context, partNumber string, opts ...RequestOption) (colors []PartColor, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/parts/%v/colors", partNumber), true, &colors, opts...)
 	return
 }
 
+// PartColorsPager returns a Pager over every page of PartColors, each
+// page decoding into a []PartColor.
+func (c *Client) PartColorsPager(partNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/parts/%v/colors", partNumber), opts...)
+}
+
 // PartColor get details about a specific Part Color combination.
-func (c *Client) PartColor(partNumber string, colorId int) (partColor PartColor, err error) {
-	err = c.get(fmt.Sprintf("lego/parts/%v/colors/%v", partNumber, colorId), false, &partColor)
+func (c *Client) PartColor(ctx context.Context, partNumber string, colorId int) (partColor PartColor, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/parts/%v/colors/%v", partNumber, colorId), false, &partColor)
 	return
 }
 
 type PartColor struct {
+	// ColorID and ColorName are only set by PartColors.
+	ColorID     int      `json:"color_id"`
+	ColorName   string   `json:"color_name"`
 	PartImgURL  string   `json:"part_img_url"`
 	YearFrom    int      `json:"year_from"`
 	YearTo      int      `json:"year_to"`
@@ -195,68 +205,140 @@ type PartColor struct {
 }
 
 // PartColorSets get a list of all Set the Part Color combination has appeared in.
-func (c *Client) PartColorSets(partNumber string, colorId int, opts ...RequestOption) (sets []Set, err error) {
-	err = c.get(fmt.Sprintf("lego/parts/%v/colors/%v/sets", partNumber, colorId), true, &sets, opts...)
+func (c *Client) PartColorSets(ctx context.Context, partNumber string, colorId int, opts ...RequestOption) (sets []Set, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/parts/%v/colors/%v/sets", partNumber, colorId), true, &sets, opts...)
 	return
 }
 
+// PartColorSetsPager returns a Pager over every page of PartColorSets, each
+// page decoding into a []Set.
+func (c *Client) PartColorSetsPager(partNumber string, colorId int, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/parts/%v/colors/%v/sets", partNumber, colorId), opts...)
+}
+
 type Part struct {
-	PartNum     string        `json:"part_num"`
-	Name        string        `json:"name"`
-	PartCatID   int           `json:"part_cat_id"`
-	YearFrom    int           `json:"year_from"`
-	YearTo      int           `json:"year_to"`
-	PartURL     string        `json:"part_url"`
-	PartImgURL  string        `json:"part_img_url"`
-	Prints      []string      `json:"prints"`
-	Molds       []interface{} `json:"molds"`
-	Alternates  []string      `json:"alternates"`
+	PartNum     string   `json:"part_num"`
+	Name        string   `json:"name"`
+	PartCatID   int      `json:"part_cat_id"`
+	YearFrom    int      `json:"year_from"`
+	YearTo      int      `json:"year_to"`
+	PartURL     string   `json:"part_url"`
+	PartImgURL  string   `json:"part_img_url"`
+	Prints      []string `json:"prints"`
+	Molds       []string `json:"molds"`
+	Alternates  []string `json:"alternates"`
 	ExternalIds struct {
-		BrickOwl []string `json:"BrickOwl"`
-		Brickset []string `json:"Brickset"`
-		LDraw    []string `json:"LDraw"`
-		LEGO     []string `json:"LEGO"`
+		BrickLink []string `json:"BrickLink"`
+		BrickOwl  []string `json:"BrickOwl"`
+		Brickset  []string `json:"Brickset"`
+		LDraw     []string `json:"LDraw"`
+		LEGO      []string `json:"LEGO"`
+		Peeron    []string `json:"Peeron"`
 	} `json:"external_ids"`
-	PrintOf interface{} `json:"print_of"`
+	PrintOf string `json:"print_of"`
 }
 
 // Sets get a list of Set.
-func (c *Client) Sets(opts ...RequestOption) (sets []Set, err error) {
-	err = c.get("lego/sets", true, &sets, opts...)
+func (c *Client) Sets(ctx context.Context, opts ...RequestOption) (sets []Set, err error) {
+	err = c.get(ctx, "lego/sets", true, &sets, opts...)
 	return
 }
 
+// SetsPager returns a Pager over every page of Sets, each
+// page decoding into a []Set.
+func (c *Client) SetsPager(opts ...RequestOption) *Pager {
+	return c.newPager("lego/sets", opts...)
+}
+
 // Set get details for a specific Set.
-func (c *Client) Set(setNumber string) (set Set, err error) {
-	err = c.get(fmt.Sprintf("lego/sets/%v", setNumber), false, &set)
+func (c *Client) Set(ctx context.Context, setNumber string) (set Set, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/sets/%v", setNumber), false, &set)
 	return
 }
 
-// SetAlternates get a list of MOCs which are alternate builds of a specific Set,
+// SetAlternates get a list of MOC which are alternate builds of a specific Set,
 // i.e. all parts in the MOC can be found in the set.
-func (c *Client) SetAlternates(setNumber string, opts ...RequestOption) (sets []Set, err error) {
-	err = c.get(fmt.Sprintf("lego/sets/%v/alternates", setNumber), true, &sets, opts...)
+func (c *Client) SetAlternates(ctx context.Context, setNumber string, opts ...RequestOption) (mocs []MOC, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/sets/%v/alternates", setNumber), true, &mocs, opts...)
 	return
 }
 
-// SetMinifigs get a list of all inventory Minifig in this Set.
-func (c *Client) SetMinifigs(setNumber string, opts ...RequestOption) (minifigs []Minifig, err error) {
-	err = c.get(fmt.Sprintf("lego/sets/%v/minifigs", setNumber), true, &minifigs, opts...)
+// SetAlternatesPager returns a Pager over every page of SetAlternates,
+// each page decoding into a []MOC.
+func (c *Client) SetAlternatesPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/sets/%v/alternates", setNumber), opts...)
+}
+
+// SetMinifigs get a list of all InventoryMinifig in this Set.
+func (c *Client) SetMinifigs(ctx context.Context, setNumber string, opts ...RequestOption) (minifigs []InventoryMinifig, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/sets/%v/minifigs", setNumber), true, &minifigs, opts...)
 	return
 }
 
-// SetParts get a list of all inventory Part in this Set.
-func (c *Client) SetParts(setNumber string, opts ...RequestOption) (parts []Part, err error) {
-	err = c.get(fmt.Sprintf("lego/sets/%v/parts", setNumber), true, &parts, opts...)
+// SetMinifigsPager returns a Pager over every page of SetMinifigs, each
+// page decoding into a []InventoryMinifig.
+func (c *Client) SetMinifigsPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/sets/%v/minifigs", setNumber), opts...)
+}
+
+// SetParts get a list of all InventoryPart in this Set.
+func (c *Client) SetParts(ctx context.Context, setNumber string, opts ...RequestOption) (parts []InventoryPart, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/sets/%v/parts", setNumber), true, &parts, opts...)
 	return
 }
 
-// SetSets get a list of all inventory Set in this Set.
-func (c *Client) SetSets(setNumber string, opts ...RequestOption) (sets []Set, err error) {
-	err = c.get(fmt.Sprintf("lego/sets/%v/sets", setNumber), true, &sets, opts...)
+// SetPartsPager returns a Pager over every page of SetParts, each
+// page decoding into a []InventoryPart.
+func (c *Client) SetPartsPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/sets/%v/parts", setNumber), opts...)
+}
+
+// SetSets get a list of all InventorySet in this Set.
+func (c *Client) SetSets(ctx context.Context, setNumber string, opts ...RequestOption) (sets []InventorySet, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/sets/%v/sets", setNumber), true, &sets, opts...)
 	return
 }
 
+// SetSetsPager returns a Pager over every page of SetSets, each
+// page decoding into a []InventorySet.
+func (c *Client) SetSetsPager(setNumber string, opts ...RequestOption) *Pager {
+	return c.newPager(fmt.Sprintf("lego/sets/%v/sets", setNumber), opts...)
+}
+
+// InventoryPart is a single row of an inventory, a quantity of
+// a Part Color combination belonging to a Set or Minifig.
+type InventoryPart struct {
+	ID        int    `json:"id"`
+	InvPartID int    `json:"inv_part_id"`
+	Part      Part   `json:"part"`
+	Color     Color  `json:"color"`
+	SetNum    string `json:"set_num"`
+	Quantity  int    `json:"quantity"`
+	IsSpare   bool   `json:"is_spare"`
+	ElementID string `json:"element_id"`
+	NumSets   int    `json:"num_sets"`
+}
+
+// InventoryMinifig is a quantity of a Minifig belonging
+// to a Set inventory.
+type InventoryMinifig struct {
+	ID        int    `json:"id"`
+	SetNum    string `json:"set_num"`
+	SetName   string `json:"set_name"`
+	Quantity  int    `json:"quantity"`
+	SetImgURL string `json:"set_img_url"`
+}
+
+// InventorySet is a quantity of a Set belonging to
+// another Set inventory.
+type InventorySet struct {
+	ID        int    `json:"id"`
+	SetNum    string `json:"set_num"`
+	SetName   string `json:"set_name"`
+	Quantity  int    `json:"quantity"`
+	SetImgURL string `json:"set_img_url"`
+}
+
 type Set struct {
 	SetNum         string    `json:"set_num"`
 	Name           string    `json:"name"`
@@ -269,14 +351,20 @@ type Set struct {
 }
 
 // Themes return all themes
-func (c *Client) Themes(opts ...RequestOption) (themes []Theme, err error) {
-	err = c.get("lego/themes", true, &themes, opts...)
+func (c *Client) Themes(ctx context.Context, opts ...RequestOption) (themes []Theme, err error) {
+	err = c.get(ctx, "lego/themes", true, &themes, opts...)
 	return
 }
 
+// ThemesPager returns a Pager over every page of Themes, each
+// page decoding into a []Theme.
+func (c *Client) ThemesPager(opts ...RequestOption) *Pager {
+	return c.newPager("lego/themes", opts...)
+}
+
 // Theme get details for a specific Theme.
-func (c *Client) Theme(id int, opts ...RequestOption) (theme Theme, err error) {
-	err = c.get(fmt.Sprintf("lego/themes/%v", id), false, &theme, opts...)
+func (c *Client) Theme(ctx context.Context, id int, opts ...RequestOption) (theme Theme, err error) {
+	err = c.get(ctx, fmt.Sprintf("lego/themes/%v", id), false, &theme, opts...)
 	return
 }
 
@@ -7,12 +7,12 @@ import (
 
 func TestLClient_Colors(t *testing.T) {
 	t.Run("Colors", func(t *testing.T) {
-		if _, err := client.Colors(); err != nil {
+		if _, err := client.Colors(ctx); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("Color", func(t *testing.T) {
-		if color, err := client.Color(212); err != nil {
+		if color, err := client.Color(ctx, 212); err != nil {
 			t.Error(err)
 		} else {
 			fmt.Println(color.Name)
@@ -21,43 +21,81 @@ func TestLClient_Colors(t *testing.T) {
 }
 
 func TestLClient_Element(t *testing.T) {
-	if _, err := client.Element("6143875"); err != nil {
+	if _, err := client.Element(ctx, "6143875"); err != nil {
 		t.Error(err)
 	}
+
+	t.Run("LDraw", func(t *testing.T) {
+		body := `{"part": {"part_num": "3001", "name": "Brick 2 x 4", "part_cat_id": 11, "year_from": 1954,
+			"year_to": 2021, "part_url": "", "part_img_url": "", "prints": [], "molds": ["3001old"],
+			"alternates": [], "external_ids": {"BrickLink": ["3001"], "LDraw": ["3001"], "LEGO": ["3001"],
+			"Peeron": ["3001"]}, "print_of": null}, "color": {"id": 4, "name": "Red", "rgb": "C91A09",
+			"is_trans": false, "external_ids": {}}, "element_id": "300121", "design_id": "3001",
+			"element_img_url": "", "part_img_url": ""}`
+		globalMock.mockResponse(200, []byte(body), func() {
+			element, err := client.Element(ctx, "300121")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ids := element.Part.ExternalIds.LDraw; len(ids) != 1 || ids[0] != "3001" || element.Part.Molds[0] != "3001old" {
+				t.Errorf("unexpected part %+v", element.Part)
+			}
+		})
+	})
 }
 
 func TestLClient_Minifigs(t *testing.T) {
 	t.Run("Minifigs", func(t *testing.T) {
-		if _, err := client.Minifigs(); err != nil {
+		if _, err := client.Minifigs(ctx); err != nil {
 			t.Error(err)
 		}
 	})
 	setNumber := "fig-000003"
 	t.Run("Minifig", func(t *testing.T) {
-		if _, err := client.Minifig(setNumber); err != nil {
+		if _, err := client.Minifig(ctx, setNumber); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("MinifigParts", func(t *testing.T) {
-		if _, err := client.MinifigParts(setNumber); err != nil {
+		if parts, err := client.MinifigParts(ctx, setNumber); err != nil {
 			t.Error(err)
+		} else if len(parts) == 0 || parts[0].Part.PartNum != "48729b" || parts[0].SetNum != setNumber {
+			t.Errorf("unexpected inventory: %+v", parts)
 		}
 	})
 	t.Run("MinifigSets", func(t *testing.T) {
-		if _, err := client.MinifigSets(setNumber); err != nil {
+		if _, err := client.MinifigSets(ctx, setNumber); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestLClient_MOCs(t *testing.T) {
+	setNumber := "MOC-33707"
+	t.Run("MOC", func(t *testing.T) {
+		if moc, err := client.MOC(ctx, setNumber); err != nil {
+			t.Error(err)
+		} else if moc.MOCURL == "" {
+			t.Errorf("unexpected moc: %+v", moc)
+		}
+	})
+	t.Run("MOCParts", func(t *testing.T) {
+		if parts, err := client.MOCParts(ctx, setNumber); err != nil {
 			t.Error(err)
+		} else if len(parts) != 1 || parts[0].SetNum != setNumber {
+			t.Errorf("unexpected inventory: %+v", parts)
 		}
 	})
 }
 
 func TestLClient_PartCategories(t *testing.T) {
 	t.Run("PartCategories", func(t *testing.T) {
-		if _, err := client.PartCategories(); err != nil {
+		if _, err := client.PartCategories(ctx); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("PartCategory", func(t *testing.T) {
-		if _, err := client.PartCategory(3); err != nil {
+		if _, err := client.PartCategory(ctx, 3); err != nil {
 			t.Error(err)
 		}
 	})
@@ -65,29 +103,29 @@ func TestLClient_PartCategories(t *testing.T) {
 
 func TestLClient_Parts(t *testing.T) {
 	t.Run("Parts", func(t *testing.T) {
-		if _, err := client.Parts(); err != nil {
+		if _, err := client.Parts(ctx); err != nil {
 			t.Error(err)
 		}
 	})
 	partNumber := "15104"
 	colorNumber := 182
 	t.Run("Part", func(t *testing.T) {
-		if _, err := client.Part(partNumber); err != nil {
+		if _, err := client.Part(ctx, partNumber); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("PartColors", func(t *testing.T) {
-		if _, err := client.PartColors(partNumber); err != nil {
+		if _, err := client.PartColors(ctx, partNumber); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("PartColor", func(t *testing.T) {
-		if _, err := client.PartColor(partNumber, colorNumber); err != nil {
+		if _, err := client.PartColor(ctx, partNumber, colorNumber); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("PartColorSets", func(t *testing.T) {
-		if _, err := client.PartColorSets(partNumber, colorNumber); err != nil {
+		if _, err := client.PartColorSets(ctx, partNumber, colorNumber); err != nil {
 			t.Error(err)
 		}
 	})
@@ -95,46 +133,54 @@ func TestLClient_Parts(t *testing.T) {
 
 func TestLClient_Sets(t *testing.T) {
 	t.Run("Sets", func(t *testing.T) {
-		if _, err := client.Sets(); err != nil {
+		if _, err := client.Sets(ctx); err != nil {
 			t.Error(err)
 		}
 	})
 	setNumber := "42102-1"
 	t.Run("Set", func(t *testing.T) {
-		if _, err := client.Set(setNumber); err != nil {
+		if _, err := client.Set(ctx, setNumber); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("SetAlternates", func(t *testing.T) {
-		if _, err := client.SetAlternates(setNumber); err != nil {
+		if mocs, err := client.SetAlternates(ctx, setNumber); err != nil {
 			t.Error(err)
+		} else if len(mocs) == 0 || mocs[0].DesignerName != "Antoineddp" {
+			t.Errorf("unexpected alternates: %+v", mocs)
 		}
 	})
 	t.Run("SetMinifigs", func(t *testing.T) {
-		if _, err := client.SetMinifigs("7018-1"); err != nil {
+		if minifigs, err := client.SetMinifigs(ctx, "7018-1"); err != nil {
 			t.Error(err)
+		} else if len(minifigs) == 0 || minifigs[0].SetNum != "fig-004673" || minifigs[0].Quantity != 1 {
+			t.Errorf("unexpected inventory: %+v", minifigs)
 		}
 	})
 	t.Run("SetParts", func(t *testing.T) {
-		if _, err := client.SetParts(setNumber); err != nil {
+		if parts, err := client.SetParts(ctx, setNumber); err != nil {
 			t.Error(err)
+		} else if len(parts) == 0 || parts[0].Part.PartNum != "3705" || parts[0].Color.ID != 0 || parts[0].ElementID != "370526" {
+			t.Errorf("unexpected inventory: %+v", parts)
 		}
 	})
 	t.Run("SetSets", func(t *testing.T) {
-		if _, err := client.SetSets("65757-1"); err != nil {
+		if sets, err := client.SetSets(ctx, "65757-1"); err != nil {
 			t.Error(err)
+		} else if len(sets) != 2 || sets[0].SetNum != "8762-1" || sets[0].Quantity != 1 {
+			t.Errorf("unexpected inventory: %+v", sets)
 		}
 	})
 }
 
 func TestLClient_Themes(t *testing.T) {
 	t.Run("Themes", func(t *testing.T) {
-		if _, err := client.Themes(); err != nil {
+		if _, err := client.Themes(ctx); err != nil {
 			t.Error(err)
 		}
 	})
 	t.Run("Theme", func(t *testing.T) {
-		if _, err := client.Theme(3); err != nil {
+		if _, err := client.Theme(ctx, 3); err != nil {
 			t.Error(err)
 		}
 	})
@@ -0,0 +1,55 @@
+package rebrickable
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// LostParts get a list of all the LostPart the user has recorded.
+func (u *UserClient) LostParts(ctx context.Context, opts ...RequestOption) (lostParts []LostPart, err error) {
+	err = u.c.get(ctx, u.endpoint("lost_parts/"), true, &lostParts, opts...)
+	return
+}
+
+// LostPartsPager returns a Pager over every page of LostParts, each
+// page decoding into a []LostPart.
+func (u *UserClient) LostPartsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("lost_parts/"), opts...)
+}
+
+// AddLostPart record quantity of an InventoryPart, identified by its
+// InvPartID, as lost.
+func (u *UserClient) AddLostPart(ctx context.Context, invPartID, quantity int) error {
+	form := url.Values{
+		"inv_part_id":   {fmt.Sprint(invPartID)},
+		"lost_quantity": {fmt.Sprint(quantity)},
+	}
+	return u.c.post(ctx, u.endpoint("lost_parts/"), form, nil)
+}
+
+// AddLostParts record several lost parts in a single request. Only
+// the lost quantity and the InvPartID of each LostPart are used.
+func (u *UserClient) AddLostParts(ctx context.Context, lostParts []LostPart) error {
+	type bulkLostPart struct {
+		InvPartID    int `json:"inv_part_id"`
+		LostQuantity int `json:"lost_quantity"`
+	}
+	body := make([]bulkLostPart, len(lostParts))
+	for i, p := range lostParts {
+		body[i] = bulkLostPart{p.InvPart.InvPartID, p.LostQuantity}
+	}
+	return u.c.jsonRequest(ctx, "POST", u.endpoint("lost_parts/"), body, nil)
+}
+
+// DeleteLostPart remove a specific LostPart, identified by its
+// LostPartID.
+func (u *UserClient) DeleteLostPart(ctx context.Context, id int) error {
+	return u.c.delete(ctx, u.endpoint("lost_parts/%v/", id))
+}
+
+type LostPart struct {
+	LostPartID   int           `json:"lost_part_id"`
+	LostQuantity int           `json:"lost_quantity"`
+	InvPart      InventoryPart `json:"inv_part"`
+}
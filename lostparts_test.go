@@ -0,0 +1,46 @@
+package rebrickable
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUserClient_LostParts(t *testing.T) {
+	t.Run("LostParts", func(t *testing.T) {
+		lostParts, err := user.LostParts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lostParts) == 0 {
+			t.Fatal("expected lost parts")
+		}
+		if p := lostParts[0]; p.LostPartID != 2057689 || p.InvPart.Part.PartNum != "4449" || p.InvPart.Color.ID != 71 {
+			t.Errorf("unexpected lost part: %+v", p)
+		}
+	})
+	t.Run("AddLostPart", func(t *testing.T) {
+		var form string
+		globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			form = req.PostForm.Encode()
+			return mockClientResponse(201, `{}`), nil
+		}
+		defer func() { globalMock.mockDo = mockResponse }()
+
+		if err := user.AddLostPart(ctx, 6952725, 1); err != nil {
+			t.Fatal(err)
+		}
+		if expected := "inv_part_id=6952725&lost_quantity=1"; form != expected {
+			t.Errorf("expected form %v, got %v", expected, form)
+		}
+	})
+	t.Run("DeleteLostPart", func(t *testing.T) {
+		globalMock.mockResponse(204, nil, func() {
+			if err := user.DeleteLostPart(ctx, 2057689); err != nil {
+				t.Error(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,73 @@
+package mirror
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Checkpoint records the newest last_modified_dt synced for sets
+// and minifigs.
+type Checkpoint struct {
+	Sets     time.Time `json:"sets"`
+	Minifigs time.Time `json:"minifigs"`
+}
+
+// Checkpoints persists the Checkpoint of a Syncer between syncs.
+type Checkpoints interface {
+	Load() (Checkpoint, error)
+	Save(Checkpoint) error
+}
+
+// FileCheckpoints is a Checkpoints storing the Checkpoint as JSON in
+// a single file.
+type FileCheckpoints struct {
+	path string
+}
+
+// NewFileCheckpoints returns a FileCheckpoints storing the Checkpoint
+// in the file at path.
+func NewFileCheckpoints(path string) *FileCheckpoints {
+	return &FileCheckpoints{path}
+}
+
+// Load returns the saved Checkpoint, or the zero Checkpoint if none
+// has been saved yet.
+func (f *FileCheckpoints) Load() (Checkpoint, error) {
+	var checkpoint Checkpoint
+	b, err := os.ReadFile(f.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return checkpoint, nil
+	} else if err != nil {
+		return checkpoint, err
+	}
+	err = json.Unmarshal(b, &checkpoint)
+	return checkpoint, err
+}
+
+func (f *FileCheckpoints) Save(checkpoint Checkpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so a crash never
+	// leaves a partially written checkpoint
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
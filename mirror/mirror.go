@@ -0,0 +1,158 @@
+// Package mirror keeps a local copy of the Rebrickable catalogue
+// current, fetching only the sets and minifigs modified since the
+// previous sync.
+package mirror
+
+import (
+	"context"
+	"time"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+// pageSize is the largest page size accepted by the API.
+const pageSize = 1000
+
+// Store receives the sets and minifigs refreshed by a Syncer. A set
+// or minifig already in the store should be replaced.
+type Store interface {
+	PutSet(ctx context.Context, set rebrickable.Set, inventory SetInventory) error
+	PutMinifig(ctx context.Context, minifig rebrickable.Minifig, parts []rebrickable.InventoryPart) error
+}
+
+// SetInventory is the complete inventory of a set.
+type SetInventory struct {
+	Parts    []rebrickable.InventoryPart
+	Minifigs []rebrickable.InventoryMinifig
+	Sets     []rebrickable.InventorySet
+}
+
+// Result reports the work done by a call to Syncer.Sync.
+type Result struct {
+	Sets       int
+	Minifigs   int
+	Checkpoint Checkpoint
+}
+
+// Syncer refreshes the sets and minifigs modified since the last
+// checkpoint, walking them in order of last_modified_dt, newest
+// first, and stopping at the first one already synced.
+type Syncer struct {
+	client      *rebrickable.Client
+	store       Store
+	checkpoints Checkpoints
+}
+
+// NewSyncer returns a Syncer fetching from client into store, keeping
+// its checkpoint in checkpoints. The first sync, starting from the zero
+// Checkpoint, fetches the whole catalogue.
+func NewSyncer(client *rebrickable.Client, store Store, checkpoints Checkpoints) *Syncer {
+	return &Syncer{client: client, store: store, checkpoints: checkpoints}
+}
+
+// Sync refreshes everything modified since the last checkpoint. The
+// checkpoint is saved after the sets and again after the minifigs are
+// synced, so the next sync after a failure repeats the whole phase
+// which failed, but not a phase which completed.
+func (s *Syncer) Sync(ctx context.Context) (Result, error) {
+	var res Result
+	checkpoint, err := s.checkpoints.Load()
+	if err != nil {
+		return res, err
+	}
+	res.Checkpoint = checkpoint
+
+	res.Sets, checkpoint.Sets, err = s.syncSets(ctx, checkpoint.Sets)
+	if err != nil {
+		return res, err
+	}
+	if err := s.checkpoints.Save(checkpoint); err != nil {
+		return res, err
+	}
+	res.Checkpoint = checkpoint
+
+	res.Minifigs, checkpoint.Minifigs, err = s.syncMinifigs(ctx, checkpoint.Minifigs)
+	if err != nil {
+		return res, err
+	}
+	if err := s.checkpoints.Save(checkpoint); err != nil {
+		return res, err
+	}
+	res.Checkpoint = checkpoint
+
+	return res, nil
+}
+
+// syncSets refreshes the sets modified after since, returning the
+// number refreshed and the newest modification time seen. On error,
+// since is returned unchanged.
+func (s *Syncer) syncSets(ctx context.Context, since time.Time) (int, time.Time, error) {
+	n, latest := 0, since
+	pager := s.client.SetsPager(rebrickable.Ordering("-last_modified_dt"), rebrickable.PageSize(pageSize))
+	for pager.More() {
+		var sets []rebrickable.Set
+		if err := pager.Next(ctx, &sets); err != nil {
+			return n, since, err
+		}
+		for _, set := range sets {
+			if !set.LastModifiedDt.After(since) {
+				return n, latest, nil
+			}
+			inventory, err := s.setInventory(ctx, set.SetNum)
+			if err != nil {
+				return n, since, err
+			}
+			if err := s.store.PutSet(ctx, set, inventory); err != nil {
+				return n, since, err
+			}
+			if set.LastModifiedDt.After(latest) {
+				latest = set.LastModifiedDt
+			}
+			n++
+		}
+	}
+	return n, latest, nil
+}
+
+// syncMinifigs is the equivalent of syncSets for minifigs.
+func (s *Syncer) syncMinifigs(ctx context.Context, since time.Time) (int, time.Time, error) {
+	n, latest := 0, since
+	pager := s.client.MinifigsPager(rebrickable.Ordering("-last_modified_dt"), rebrickable.PageSize(pageSize))
+	for pager.More() {
+		var minifigs []rebrickable.Minifig
+		if err := pager.Next(ctx, &minifigs); err != nil {
+			return n, since, err
+		}
+		for _, minifig := range minifigs {
+			if !minifig.LastModifiedDt.After(since) {
+				return n, latest, nil
+			}
+			var parts []rebrickable.InventoryPart
+			if err := s.client.MinifigPartsPager(minifig.SetNum, rebrickable.PageSize(pageSize)).All(ctx, &parts); err != nil {
+				return n, since, err
+			}
+			if err := s.store.PutMinifig(ctx, minifig, parts); err != nil {
+				return n, since, err
+			}
+			if minifig.LastModifiedDt.After(latest) {
+				latest = minifig.LastModifiedDt
+			}
+			n++
+		}
+	}
+	return n, latest, nil
+}
+
+func (s *Syncer) setInventory(ctx context.Context, setNumber string) (SetInventory, error) {
+	var inventory SetInventory
+	if err := s.client.SetPartsPager(setNumber, rebrickable.PageSize(pageSize)).All(ctx, &inventory.Parts); err != nil {
+		return inventory, err
+	}
+	if err := s.client.SetMinifigsPager(setNumber, rebrickable.PageSize(pageSize)).All(ctx, &inventory.Minifigs); err != nil {
+		return inventory, err
+	}
+	if err := s.client.SetSetsPager(setNumber, rebrickable.PageSize(pageSize)).All(ctx, &inventory.Sets); err != nil {
+		return inventory, err
+	}
+	return inventory, nil
+}
@@ -0,0 +1,189 @@
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+var ctx = context.Background()
+
+type memoryStore struct {
+	sets     map[string]SetInventory
+	minifigs map[string][]rebrickable.InventoryPart
+
+	// minifigErr is returned by the next PutMinifig, if set
+	minifigErr error
+}
+
+func (m *memoryStore) PutSet(ctx context.Context, set rebrickable.Set, inventory SetInventory) error {
+	m.sets[set.SetNum] = inventory
+	return nil
+}
+
+func (m *memoryStore) PutMinifig(ctx context.Context, minifig rebrickable.Minifig, parts []rebrickable.InventoryPart) error {
+	if err := m.minifigErr; err != nil {
+		m.minifigErr = nil
+		return err
+	}
+	m.minifigs[minifig.SetNum] = parts
+	return nil
+}
+
+// catalogServer serves sets and minifigs, newest first, counting
+// the requests made for each path.
+func catalogServer(t *testing.T, sets []rebrickable.Set, minifigs []rebrickable.Minifig, requests map[string]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		var results interface{}
+		switch path := strings.TrimPrefix(r.URL.Path, "/lego/"); {
+		case path == "sets" || path == "minifigs":
+			if r.URL.Query().Get("ordering") != "-last_modified_dt" {
+				t.Errorf("unexpected ordering: %v", r.URL.RawQuery)
+			}
+			results = sets
+			if path == "minifigs" {
+				results = minifigs
+			}
+		case strings.HasSuffix(path, "/parts"):
+			num := strings.Split(path, "/")[1]
+			results = []rebrickable.InventoryPart{{SetNum: num, Quantity: 1, Part: rebrickable.Part{PartNum: "3001"}}}
+		case strings.HasSuffix(path, "/minifigs"), strings.HasSuffix(path, "/sets"):
+			results = []struct{}{}
+		default:
+			t.Errorf("unexpected path: %v", r.URL.Path)
+		}
+		b, _ := json.Marshal(results)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(rebrickable.PaginatedResponse{Count: 1, Results: b})
+	}))
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	sets := []rebrickable.Set{
+		{SetNum: "3000-1", LastModifiedDt: day.Add(time.Hour * 48)},
+		{SetNum: "2000-1", LastModifiedDt: day.Add(time.Hour * 24)},
+		{SetNum: "1000-1", LastModifiedDt: day},
+	}
+	minifigs := []rebrickable.Minifig{
+		{SetNum: "fig-000001", LastModifiedDt: day},
+	}
+
+	requests := make(map[string]int)
+	server := catalogServer(t, sets, minifigs, requests)
+	defer server.Close()
+
+	client := rebrickable.NewClient("", rebrickable.BaseURL(server.URL))
+	checkpoints := NewFileCheckpoints(filepath.Join(t.TempDir(), "checkpoint.json"))
+	store := &memoryStore{sets: make(map[string]SetInventory), minifigs: make(map[string][]rebrickable.InventoryPart)}
+	syncer := NewSyncer(client, store, checkpoints)
+
+	t.Run("Initial", func(t *testing.T) {
+		res, err := syncer.Sync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Sets != 3 || res.Minifigs != 1 {
+			t.Errorf("unexpected result %+v", res)
+		}
+		if !res.Checkpoint.Sets.Equal(sets[0].LastModifiedDt) || !res.Checkpoint.Minifigs.Equal(day) {
+			t.Errorf("unexpected checkpoint %+v", res.Checkpoint)
+		}
+		if parts := store.sets["2000-1"].Parts; len(parts) != 1 || parts[0].SetNum != "2000-1" {
+			t.Errorf("unexpected parts %+v", parts)
+		}
+		if len(store.minifigs["fig-000001"]) != 1 {
+			t.Errorf("unexpected minifig parts %+v", store.minifigs)
+		}
+	})
+
+	t.Run("Incremental", func(t *testing.T) {
+		sets[0].LastModifiedDt = day.Add(time.Hour * 72)
+		for k := range requests {
+			delete(requests, k)
+		}
+
+		res, err := syncer.Sync(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Sets != 1 || res.Minifigs != 0 {
+			t.Errorf("unexpected result %+v", res)
+		}
+		if requests["/lego/sets/3000-1/parts"] != 1 || requests["/lego/sets/2000-1/parts"] != 0 {
+			t.Errorf("unexpected requests %v", requests)
+		}
+
+		saved, err := checkpoints.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !saved.Sets.Equal(sets[0].LastModifiedDt) {
+			t.Errorf("unexpected saved checkpoint %+v", saved)
+		}
+	})
+}
+
+func TestSyncer_Resume(t *testing.T) {
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	sets := []rebrickable.Set{{SetNum: "1000-1", LastModifiedDt: day}}
+	minifigs := []rebrickable.Minifig{{SetNum: "fig-000001", LastModifiedDt: day}}
+
+	requests := make(map[string]int)
+	server := catalogServer(t, sets, minifigs, requests)
+	defer server.Close()
+
+	client := rebrickable.NewClient("", rebrickable.BaseURL(server.URL))
+	checkpoints := NewFileCheckpoints(filepath.Join(t.TempDir(), "checkpoint.json"))
+	store := &memoryStore{
+		sets:       make(map[string]SetInventory),
+		minifigs:   make(map[string][]rebrickable.InventoryPart),
+		minifigErr: errors.New("disk full"),
+	}
+	syncer := NewSyncer(client, store, checkpoints)
+
+	if _, err := syncer.Sync(ctx); err == nil {
+		t.Fatal("expected the minifigs to fail")
+	}
+	saved, err := checkpoints.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !saved.Sets.Equal(day) || !saved.Minifigs.IsZero() {
+		t.Errorf("unexpected saved checkpoint %+v", saved)
+	}
+
+	// the sets completed, so only the minifigs are repeated
+	for k := range requests {
+		delete(requests, k)
+	}
+	res, err := syncer.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Sets != 0 || res.Minifigs != 1 || requests["/lego/sets/1000-1/parts"] != 0 {
+		t.Errorf("unexpected result %+v, requests %v", res, requests)
+	}
+	if len(store.minifigs["fig-000001"]) != 1 {
+		t.Errorf("unexpected minifig parts %+v", store.minifigs)
+	}
+}
+
+func TestFileCheckpoints_Load(t *testing.T) {
+	checkpoint, err := NewFileCheckpoints(filepath.Join(t.TempDir(), "missing.json")).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !checkpoint.Sets.IsZero() || !checkpoint.Minifigs.IsZero() {
+		t.Errorf("expected zero checkpoint, got %+v", checkpoint)
+	}
+}
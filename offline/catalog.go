@@ -0,0 +1,276 @@
+// Package offline answers the same queries as rebrickable.Client using
+// the CSV downloads available from https://rebrickable.com/downloads/,
+// without making any network requests.
+package offline
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+// Catalog is an in-memory copy of the Rebrickable catalogue, loaded
+// from the CSV downloads, implementing rebrickable.Catalog. A Catalog
+// is safe for concurrent use once opened.
+type Catalog struct {
+	colors      map[int]rebrickable.Color
+	colorIDs    []int
+	themes      map[int]rebrickable.Theme
+	themeIDs    []int
+	categories  map[int]rebrickable.PartCategory
+	categoryIDs []int
+	parts       map[string]*rebrickable.Part
+	partNums    []string
+	elements    map[string]element
+	sets        map[string]rebrickable.Set
+	setNums     []string
+	minifigs    map[string]rebrickable.Minifig
+	figNums     []string
+
+	// partColorElements maps each part and color combination
+	// to the IDs of its elements
+	partColorElements map[partColor][]string
+
+	// inventories maps each set or minifig number to the ID of
+	// its inventory, and owners maps it back
+	inventories map[string]int
+	owners      map[int]string
+
+	invParts    map[int][]invPart
+	invMinifigs map[int][]invItem
+	invSets     map[int][]invItem
+
+	// partUses and figUses index the inventories each part
+	// and minifig appear in
+	partUses map[string][]partUse
+	figUses  map[string][]int
+}
+
+type element struct {
+	id       string
+	partNum  string
+	colorID  int
+	designID string
+}
+
+type partColor struct {
+	partNum string
+	colorID int
+}
+
+type invPart struct {
+	partNum  string
+	colorID  int
+	quantity int
+	isSpare  bool
+}
+
+type invItem struct {
+	num      string
+	quantity int
+}
+
+type partUse struct {
+	inventory int
+	colorID   int
+	quantity  int
+}
+
+var _ rebrickable.Catalog = (*Catalog)(nil)
+
+// Files are the names of the CSV downloads read by Open, in the
+// order they are read.
+var Files = []string{
+	"colors",
+	"themes",
+	"part_categories",
+	"parts",
+	"part_relationships",
+	"elements",
+	"sets",
+	"minifigs",
+	"inventories",
+	"inventory_parts",
+	"inventory_minifigs",
+	"inventory_sets",
+}
+
+// Open loads a Catalog from the CSV downloads in dir, named as they
+// are on the downloads page (e.g. sets.csv.gz), gzipped or not. Files
+// missing from dir are skipped, so only the queries they answer are
+// affected.
+func Open(dir string) (*Catalog, error) {
+	c := &Catalog{
+		colors:            make(map[int]rebrickable.Color),
+		themes:            make(map[int]rebrickable.Theme),
+		categories:        make(map[int]rebrickable.PartCategory),
+		parts:             make(map[string]*rebrickable.Part),
+		elements:          make(map[string]element),
+		sets:              make(map[string]rebrickable.Set),
+		minifigs:          make(map[string]rebrickable.Minifig),
+		partColorElements: make(map[partColor][]string),
+		inventories:       make(map[string]int),
+		owners:            make(map[int]string),
+		invParts:          make(map[int][]invPart),
+		invMinifigs:       make(map[int][]invItem),
+		invSets:           make(map[int][]invItem),
+		partUses:          make(map[string][]partUse),
+		figUses:           make(map[string][]int),
+	}
+
+	// versions of each inventory, only the first version
+	// of an inventory is used, as it is by the API
+	versions := make(map[string]int)
+
+	loaders := map[string]func(r *record){
+		"colors": func(r *record) {
+			color := rebrickable.Color{
+				ID:      r.int("id"),
+				Name:    r.string("name"),
+				Rgb:     r.string("rgb"),
+				IsTrans: r.bool("is_trans"),
+			}
+			c.colors[color.ID] = color
+			c.colorIDs = append(c.colorIDs, color.ID)
+		},
+		"themes": func(r *record) {
+			theme := rebrickable.Theme{
+				ID:       r.int("id"),
+				ParentID: r.int("parent_id"),
+				Name:     r.string("name"),
+			}
+			c.themes[theme.ID] = theme
+			c.themeIDs = append(c.themeIDs, theme.ID)
+		},
+		"part_categories": func(r *record) {
+			category := rebrickable.PartCategory{
+				ID:   r.int("id"),
+				Name: r.string("name"),
+			}
+			c.categories[category.ID] = category
+			c.categoryIDs = append(c.categoryIDs, category.ID)
+		},
+		"parts": func(r *record) {
+			part := &rebrickable.Part{
+				PartNum:   r.string("part_num"),
+				Name:      r.string("name"),
+				PartCatID: r.int("part_cat_id"),
+			}
+			c.parts[part.PartNum] = part
+			c.partNums = append(c.partNums, part.PartNum)
+			if category, ok := c.categories[part.PartCatID]; ok {
+				category.PartCount++
+				c.categories[part.PartCatID] = category
+			}
+		},
+		"part_relationships": func(r *record) {
+			child, parent := c.parts[r.string("child_part_num")], c.parts[r.string("parent_part_num")]
+			if child == nil || parent == nil {
+				return
+			}
+			switch r.string("rel_type") {
+			case "P":
+				child.PrintOf = parent.PartNum
+				parent.Prints = append(parent.Prints, child.PartNum)
+			case "M":
+				child.Molds = append(child.Molds, parent.PartNum)
+				parent.Molds = append(parent.Molds, child.PartNum)
+			case "A":
+				child.Alternates = append(child.Alternates, parent.PartNum)
+				parent.Alternates = append(parent.Alternates, child.PartNum)
+			}
+		},
+		"elements": func(r *record) {
+			e := element{
+				id:       r.string("element_id"),
+				partNum:  r.string("part_num"),
+				colorID:  r.int("color_id"),
+				designID: r.string("design_id"),
+			}
+			c.elements[e.id] = e
+			key := partColor{e.partNum, e.colorID}
+			c.partColorElements[key] = append(c.partColorElements[key], e.id)
+		},
+		"sets": func(r *record) {
+			set := rebrickable.Set{
+				SetNum:    r.string("set_num"),
+				Name:      r.string("name"),
+				Year:      r.int("year"),
+				ThemeID:   r.int("theme_id"),
+				NumParts:  r.int("num_parts"),
+				SetImgURL: r.string("img_url"),
+			}
+			c.sets[set.SetNum] = set
+			c.setNums = append(c.setNums, set.SetNum)
+		},
+		"minifigs": func(r *record) {
+			minifig := rebrickable.Minifig{
+				SetNum:    r.string("fig_num"),
+				Name:      r.string("name"),
+				NumParts:  r.int("num_parts"),
+				SetImgURL: r.string("img_url"),
+			}
+			c.minifigs[minifig.SetNum] = minifig
+			c.figNums = append(c.figNums, minifig.SetNum)
+		},
+		"inventories": func(r *record) {
+			id, version, num := r.int("id"), r.int("version"), r.string("set_num")
+			if v, ok := versions[num]; ok && v <= version {
+				return
+			}
+			if previous, ok := c.inventories[num]; ok {
+				delete(c.owners, previous)
+			}
+			versions[num] = version
+			c.inventories[num] = id
+			c.owners[id] = num
+		},
+		"inventory_parts": func(r *record) {
+			id := r.int("inventory_id")
+			if _, ok := c.owners[id]; !ok {
+				return
+			}
+			p := invPart{
+				partNum:  r.string("part_num"),
+				colorID:  r.int("color_id"),
+				quantity: r.int("quantity"),
+				isSpare:  r.bool("is_spare"),
+			}
+			c.invParts[id] = append(c.invParts[id], p)
+			c.partUses[p.partNum] = append(c.partUses[p.partNum], partUse{id, p.colorID, p.quantity})
+		},
+		"inventory_minifigs": func(r *record) {
+			id := r.int("inventory_id")
+			if _, ok := c.owners[id]; !ok {
+				return
+			}
+			fig := invItem{r.string("fig_num"), r.int("quantity")}
+			c.invMinifigs[id] = append(c.invMinifigs[id], fig)
+			c.figUses[fig.num] = append(c.figUses[fig.num], id)
+		},
+		"inventory_sets": func(r *record) {
+			id := r.int("inventory_id")
+			if _, ok := c.owners[id]; !ok {
+				return
+			}
+			c.invSets[id] = append(c.invSets[id], invItem{r.string("set_num"), r.int("quantity")})
+		},
+	}
+
+	for _, name := range Files {
+		if _, err := readCSV(dir, name, loaders[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Ints(c.colorIDs)
+	sort.Ints(c.themeIDs)
+	sort.Ints(c.categoryIDs)
+
+	return c, nil
+}
+
+func notFound(kind string, id interface{}) error {
+	return fmt.Errorf("offline: %v %q: %w", kind, fmt.Sprint(id), rebrickable.ErrNotFound)
+}
@@ -0,0 +1,213 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+var ctx = context.Background()
+
+func openTestCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	c, err := Open("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestOpen(t *testing.T) {
+	c := openTestCatalog(t)
+
+	t.Run("MissingDir", func(t *testing.T) {
+		empty, err := Open(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sets, _ := empty.Sets(ctx); len(sets) != 0 {
+			t.Errorf("expected no sets, got %v", len(sets))
+		}
+	})
+	t.Run("Uncompressed", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := ioutil.WriteFile(filepath.Join(dir, "themes.csv"), []byte("id,name,parent_id\n1,Technic,\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		plain, err := Open(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if theme, err := plain.Theme(ctx, 1); err != nil || theme.Name != "Technic" {
+			t.Errorf("unexpected theme %+v: %v", theme, err)
+		}
+	})
+	t.Run("Relationships", func(t *testing.T) {
+		part, err := c.Part(ctx, "3001pr0001")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if part.PrintOf != "3001" {
+			t.Errorf("expected print of 3001, got %q", part.PrintOf)
+		}
+		mold, _ := c.Part(ctx, "3001b")
+		if len(mold.Molds) != 1 || mold.Molds[0] != "3001" {
+			t.Errorf("unexpected molds %v", mold.Molds)
+		}
+	})
+	t.Run("PartCount", func(t *testing.T) {
+		category, err := c.PartCategory(ctx, 11)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if category.PartCount != 3 {
+			t.Errorf("expected 3 parts, got %v", category.PartCount)
+		}
+	})
+}
+
+func TestCatalog_NotFound(t *testing.T) {
+	c := openTestCatalog(t)
+
+	if _, err := c.Set(ctx, "9999-1"); !errors.Is(err, rebrickable.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := c.PartColor(ctx, "3001", 15); !errors.Is(err, rebrickable.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCatalog_Unsupported(t *testing.T) {
+	c := openTestCatalog(t)
+
+	if _, err := c.Parts(ctx, rebrickable.BrickLinkID("3001")); !errors.Is(err, rebrickable.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+	if _, err := c.Colors(ctx, rebrickable.ThemeID(1)); !errors.Is(err, rebrickable.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+	if _, err := c.Sets(ctx, rebrickable.ThemeID(1), rebrickable.Ordering("year"), rebrickable.PageSize(1)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCatalog_Sets(t *testing.T) {
+	c := openTestCatalog(t)
+
+	t.Run("Filters", func(t *testing.T) {
+		sets, err := c.Sets(ctx, rebrickable.ThemeID(1), rebrickable.MinYear(2006))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sets) != 1 || sets[0].SetNum != "3000-1" {
+			t.Errorf("unexpected sets %+v", sets)
+		}
+	})
+	t.Run("Ordering", func(t *testing.T) {
+		sets, err := c.Sets(ctx, rebrickable.Ordering("-year"), rebrickable.PageSize(2), rebrickable.Page(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sets) != 1 || sets[0].SetNum != "1000-1" {
+			t.Errorf("unexpected sets %+v", sets)
+		}
+	})
+	t.Run("SetSets", func(t *testing.T) {
+		sets, err := c.SetSets(ctx, "3000-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sets) != 2 || sets[1].SetName != "Starter Pack" {
+			t.Errorf("unexpected sets %+v", sets)
+		}
+	})
+}
+
+func TestCatalog_SetParts(t *testing.T) {
+	c := openTestCatalog(t)
+
+	t.Run("Version", func(t *testing.T) {
+		parts, err := c.SetParts(ctx, "1000-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(parts) != 3 {
+			t.Fatalf("expected 3 parts, got %v", len(parts))
+		}
+		if p := parts[0]; p.Part.Name != "Brick 2 x 4" || p.Color.Name != "Red" || p.Quantity != 4 || p.ElementID != "300121" {
+			t.Errorf("unexpected part %+v", p)
+		}
+		if !parts[2].IsSpare {
+			t.Error("expected spare part")
+		}
+	})
+	t.Run("IncMinifigParts", func(t *testing.T) {
+		parts, err := c.SetParts(ctx, "2000-1", rebrickable.IncMinifigParts(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(parts) != 4 {
+			t.Fatalf("expected 4 parts, got %v", len(parts))
+		}
+		if p := parts[2]; p.Part.PartNum != "3626cpr0001" || p.Quantity != 2 || p.SetNum != "fig-000001" {
+			t.Errorf("unexpected part %+v", p)
+		}
+	})
+	t.Run("SetMinifigs", func(t *testing.T) {
+		minifigs, err := c.SetMinifigs(ctx, "2000-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(minifigs) != 1 || minifigs[0].Quantity != 2 || minifigs[0].SetName != "Pilot" {
+			t.Errorf("unexpected minifigs %+v", minifigs)
+		}
+	})
+}
+
+func TestCatalog_Parts(t *testing.T) {
+	c := openTestCatalog(t)
+
+	t.Run("PartColors", func(t *testing.T) {
+		colors, err := c.PartColors(ctx, "3023")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(colors) != 2 || colors[0].ColorName != "Black" || colors[1].ColorName != "White" || colors[1].NumSets != 2 {
+			t.Errorf("unexpected colors %+v", colors)
+		}
+	})
+	t.Run("PartColor", func(t *testing.T) {
+		details, err := c.PartColor(ctx, "3023", 15)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if details.NumSets != 2 || details.NumSetParts != 5 || details.YearFrom != 1999 || details.YearTo != 2005 {
+			t.Errorf("unexpected details %+v", details)
+		}
+		if len(details.Elements) != 1 || details.Elements[0] != "302301" {
+			t.Errorf("unexpected elements %v", details.Elements)
+		}
+	})
+	t.Run("MinifigSets", func(t *testing.T) {
+		sets, err := c.MinifigSets(ctx, "fig-000001")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sets) != 1 || sets[0].SetNum != "2000-1" {
+			t.Errorf("unexpected sets %+v", sets)
+		}
+	})
+	t.Run("Search", func(t *testing.T) {
+		parts, err := c.Parts(ctx, rebrickable.Search("minifig head"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(parts) != 2 {
+			t.Errorf("expected 2 parts, got %v", len(parts))
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package offline
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// record is a row of a CSV file, whose values are looked up by the
+// name of their column. Any value which fails to parse is recorded
+// in err, so a row can be parsed without checking each value.
+type record struct {
+	file   string
+	line   int
+	header map[string]int
+	values []string
+	err    error
+}
+
+func (r *record) string(column string) string {
+	i, ok := r.header[column]
+	if !ok || i >= len(r.values) {
+		return ""
+	}
+	return r.values[i]
+}
+
+func (r *record) int(column string) int {
+	v := r.string(column)
+	if v == "" {
+		return 0
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("offline: %v line %v: invalid %v %q", r.file, r.line, column, v)
+	}
+	return i
+}
+
+func (r *record) bool(column string) bool {
+	switch strings.ToLower(r.string(column)) {
+	case "t", "true", "1":
+		return true
+	}
+	return false
+}
+
+// readCSV calls fn for each row of the CSV file name in dir, which
+// may be gzipped (name.csv.gz) or not (name.csv). It reports whether
+// the file exists.
+func readCSV(dir, name string, fn func(r *record)) (bool, error) {
+	path := filepath.Join(dir, name+".csv.gz")
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		path = filepath.Join(dir, name+".csv")
+		f, err = os.Open(path)
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	var src io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return true, fmt.Errorf("offline: %v: %w", path, err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+
+	cr := csv.NewReader(src)
+	cr.ReuseRecord = true
+	cr.FieldsPerRecord = -1
+
+	columns, err := cr.Read()
+	if err != nil {
+		return true, fmt.Errorf("offline: %v: %w", path, err)
+	}
+	r := &record{file: filepath.Base(path), header: make(map[string]int, len(columns))}
+	for i, column := range columns {
+		r.header[strings.TrimPrefix(column, "\ufeff")] = i
+	}
+
+	for r.line = 2; ; r.line++ {
+		values, err := cr.Read()
+		if err == io.EOF {
+			return true, nil
+		} else if err != nil {
+			return true, fmt.Errorf("offline: %v: %w", path, err)
+		}
+		r.values = values
+		fn(r)
+		if r.err != nil {
+			return true, r.err
+		}
+	}
+}
@@ -0,0 +1,549 @@
+package offline
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+// Colors get a list of all Color.
+func (c *Catalog) Colors(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Color, error) {
+	q, err := newQuery(opts, listParams)
+	if err != nil {
+		return nil, err
+	}
+	colors := make([]rebrickable.Color, 0, len(c.colorIDs))
+	for _, id := range c.colorIDs {
+		colors = append(colors, c.colors[id])
+	}
+	q.order(colors, map[string]func(i, j int) bool{
+		"id":   func(i, j int) bool { return colors[i].ID < colors[j].ID },
+		"name": func(i, j int) bool { return colors[i].Name < colors[j].Name },
+	})
+	start, end := q.page(len(colors))
+	return colors[start:end], nil
+}
+
+// Color get details about a specific Color.
+func (c *Catalog) Color(ctx context.Context, id int, opts ...rebrickable.RequestOption) (rebrickable.Color, error) {
+	if _, err := newQuery(opts, nil); err != nil {
+		return rebrickable.Color{}, err
+	}
+	color, ok := c.colors[id]
+	if !ok {
+		return color, notFound("color", id)
+	}
+	return color, nil
+}
+
+// Element get details about a specific Element ID.
+func (c *Catalog) Element(ctx context.Context, id string) (rebrickable.Element, error) {
+	var element rebrickable.Element
+	e, ok := c.elements[id]
+	if !ok {
+		return element, notFound("element", id)
+	}
+
+	element.ElementID = e.id
+	element.DesignID = e.designID
+	if part, ok := c.parts[e.partNum]; ok {
+		element.Part = c.part(part)
+	} else {
+		element.Part.PartNum = e.partNum
+	}
+	element.Color = c.colors[e.colorID]
+	element.Color.ID = e.colorID
+
+	return element, nil
+}
+
+// Minifigs get a list of Minifig.
+func (c *Catalog) Minifigs(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Minifig, error) {
+	q, err := newQuery(opts, withParams(listParams, "min_parts", "max_parts", "in_set_num", "in_theme_id", "search"))
+	if err != nil {
+		return nil, err
+	}
+
+	// restrict to the minifigs in a set, or in the sets of a theme
+	var in map[string]bool
+	setNum, filterSet := q.Get("in_set_num"), q.Get("in_set_num") != ""
+	themeID, filterTheme := q.int("in_theme_id")
+	if filterSet || filterTheme {
+		in = make(map[string]bool)
+		for _, num := range c.setNums {
+			if (filterSet && num != setNum) || (filterTheme && c.sets[num].ThemeID != themeID) {
+				continue
+			}
+			for _, fig := range c.invMinifigs[c.inventories[num]] {
+				in[fig.num] = true
+			}
+		}
+	}
+
+	var minifigs []rebrickable.Minifig
+	for _, figNum := range c.figNums {
+		minifig := c.minifigs[figNum]
+		if in != nil && !in[figNum] {
+			continue
+		}
+		if q.between("min_parts", "max_parts", minifig.NumParts) && q.search(minifig.SetNum, minifig.Name) {
+			minifigs = append(minifigs, minifig)
+		}
+	}
+	q.order(minifigs, map[string]func(i, j int) bool{
+		"set_num":   func(i, j int) bool { return minifigs[i].SetNum < minifigs[j].SetNum },
+		"name":      func(i, j int) bool { return minifigs[i].Name < minifigs[j].Name },
+		"num_parts": func(i, j int) bool { return minifigs[i].NumParts < minifigs[j].NumParts },
+	})
+	start, end := q.page(len(minifigs))
+	return minifigs[start:end], nil
+}
+
+// Minifig get details for a specific Minifig.
+func (c *Catalog) Minifig(ctx context.Context, setNumber string) (rebrickable.Minifig, error) {
+	minifig, ok := c.minifigs[setNumber]
+	if !ok {
+		return minifig, notFound("minifig", setNumber)
+	}
+	return minifig, nil
+}
+
+// MinifigParts get a list of all InventoryPart in this Minifig.
+func (c *Catalog) MinifigParts(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.InventoryPart, error) {
+	if _, ok := c.minifigs[setNumber]; !ok {
+		return nil, notFound("minifig", setNumber)
+	}
+	q, err := newQuery(opts, pageParams)
+	if err != nil {
+		return nil, err
+	}
+	parts := c.inventoryParts(setNumber, 1)
+	start, end := q.page(len(parts))
+	return parts[start:end], nil
+}
+
+// MinifigSets get a list of Set a Minifig has appeared in.
+func (c *Catalog) MinifigSets(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.Set, error) {
+	if _, ok := c.minifigs[setNumber]; !ok {
+		return nil, notFound("minifig", setNumber)
+	}
+	q, err := newQuery(opts, pageParams)
+	if err != nil {
+		return nil, err
+	}
+	sets := c.ownerSets(c.figUses[setNumber])
+	start, end := q.page(len(sets))
+	return sets[start:end], nil
+}
+
+// MOC MOCs are not part of the CSV downloads, so ErrNotFound
+// is always returned.
+func (c *Catalog) MOC(ctx context.Context, setNumber string) (rebrickable.MOC, error) {
+	return rebrickable.MOC{}, notFound("moc", setNumber)
+}
+
+// MOCParts MOCs are not part of the CSV downloads, so ErrNotFound
+// is always returned.
+func (c *Catalog) MOCParts(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.InventoryPart, error) {
+	return nil, notFound("moc", setNumber)
+}
+
+// PartCategories get a list of all PartCategory.
+func (c *Catalog) PartCategories(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.PartCategory, error) {
+	q, err := newQuery(opts, listParams)
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]rebrickable.PartCategory, 0, len(c.categoryIDs))
+	for _, id := range c.categoryIDs {
+		categories = append(categories, c.categories[id])
+	}
+	q.order(categories, map[string]func(i, j int) bool{
+		"id":         func(i, j int) bool { return categories[i].ID < categories[j].ID },
+		"name":       func(i, j int) bool { return categories[i].Name < categories[j].Name },
+		"part_count": func(i, j int) bool { return categories[i].PartCount < categories[j].PartCount },
+	})
+	start, end := q.page(len(categories))
+	return categories[start:end], nil
+}
+
+// PartCategory get details about a specific PartCategory.
+func (c *Catalog) PartCategory(ctx context.Context, id int, opts ...rebrickable.RequestOption) (rebrickable.PartCategory, error) {
+	if _, err := newQuery(opts, nil); err != nil {
+		return rebrickable.PartCategory{}, err
+	}
+	category, ok := c.categories[id]
+	if !ok {
+		return category, notFound("part category", id)
+	}
+	return category, nil
+}
+
+// Parts get a list of Part. External IDs are not part of the CSV
+// downloads, so filtering by the BrickLink, BrickOwl, LEGO or LDraw
+// ID of a part returns an error wrapping rebrickable.ErrUnsupported.
+func (c *Catalog) Parts(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Part, error) {
+	q, err := newQuery(opts, withParams(pageParams, "part_num", "part_nums", "part_cat_id", "color_id", "search", "inc_part_details"))
+	if err != nil {
+		return nil, err
+	}
+
+	partNums := c.partNums
+	if partNum := q.Get("part_num"); partNum != "" {
+		partNums = []string{partNum}
+	} else if nums := q.Get("part_nums"); nums != "" {
+		partNums = splitComma(nums)
+	}
+	colorID, filterColor := q.int("color_id")
+
+	var matches []*rebrickable.Part
+	for _, partNum := range partNums {
+		part, ok := c.parts[partNum]
+		if !ok || !q.equals("part_cat_id", part.PartCatID) || !q.search(part.PartNum, part.Name) {
+			continue
+		}
+		if filterColor && !c.partInColor(partNum, colorID) {
+			continue
+		}
+		matches = append(matches, part)
+	}
+
+	start, end := q.page(len(matches))
+	parts := make([]rebrickable.Part, 0, end-start)
+	for _, part := range matches[start:end] {
+		parts = append(parts, c.part(part))
+	}
+	return parts, nil
+}
+
+// Part get details about a specific Part.
+func (c *Catalog) Part(ctx context.Context, partNumber string) (rebrickable.Part, error) {
+	part, ok := c.parts[partNumber]
+	if !ok {
+		return rebrickable.Part{}, notFound("part", partNumber)
+	}
+	return c.part(part), nil
+}
+
+// part returns a copy of part, along with the years it
+// appeared in sets.
+func (c *Catalog) part(part *rebrickable.Part) rebrickable.Part {
+	p := *part
+	for _, use := range c.partUses[p.PartNum] {
+		if set, ok := c.sets[c.owners[use.inventory]]; ok {
+			if p.YearFrom == 0 || set.Year < p.YearFrom {
+				p.YearFrom = set.Year
+			}
+			if set.Year > p.YearTo {
+				p.YearTo = set.Year
+			}
+		}
+	}
+	return p
+}
+
+func (c *Catalog) partInColor(partNum string, colorID int) bool {
+	for _, use := range c.partUses[partNum] {
+		if use.colorID == colorID {
+			return true
+		}
+	}
+	return len(c.partColorElements[partColor{partNum, colorID}]) > 0
+}
+
+// PartColors get a list of all PartColor a Part has appeared in.
+func (c *Catalog) PartColors(ctx context.Context, partNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.PartColor, error) {
+	if _, ok := c.parts[partNumber]; !ok {
+		return nil, notFound("part", partNumber)
+	}
+	q, err := newQuery(opts, pageParams)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	var colorIDs []int
+	for _, use := range c.partUses[partNumber] {
+		if !seen[use.colorID] {
+			seen[use.colorID] = true
+			colorIDs = append(colorIDs, use.colorID)
+		}
+	}
+	sort.Ints(colorIDs)
+
+	start, end := q.page(len(colorIDs))
+	colors := make([]rebrickable.PartColor, 0, end-start)
+	for _, id := range colorIDs[start:end] {
+		details, err := c.PartColor(ctx, partNumber, id)
+		if err != nil {
+			return nil, err
+		}
+		details.ColorID, details.ColorName = id, c.colors[id].Name
+		colors = append(colors, details)
+	}
+	return colors, nil
+}
+
+// PartColor get details about a specific Part Color combination.
+func (c *Catalog) PartColor(ctx context.Context, partNumber string, colorId int) (rebrickable.PartColor, error) {
+	var details rebrickable.PartColor
+	if !c.partInColor(partNumber, colorId) {
+		return details, notFound("part color", partNumber)
+	}
+
+	seen := make(map[string]bool)
+	for _, use := range c.partUses[partNumber] {
+		if use.colorID != colorId {
+			continue
+		}
+		set, ok := c.sets[c.owners[use.inventory]]
+		if !ok {
+			continue
+		}
+		details.NumSetParts += use.quantity
+		if !seen[set.SetNum] {
+			seen[set.SetNum] = true
+			details.NumSets++
+		}
+		if details.YearFrom == 0 || set.Year < details.YearFrom {
+			details.YearFrom = set.Year
+		}
+		if set.Year > details.YearTo {
+			details.YearTo = set.Year
+		}
+	}
+	details.Elements = append([]string{}, c.partColorElements[partColor{partNumber, colorId}]...)
+
+	return details, nil
+}
+
+// PartColorSets get a list of all Set the Part Color combination has appeared in.
+func (c *Catalog) PartColorSets(ctx context.Context, partNumber string, colorId int, opts ...rebrickable.RequestOption) ([]rebrickable.Set, error) {
+	if !c.partInColor(partNumber, colorId) {
+		return nil, notFound("part color", partNumber)
+	}
+	q, err := newQuery(opts, pageParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var inventories []int
+	for _, use := range c.partUses[partNumber] {
+		if use.colorID == colorId {
+			inventories = append(inventories, use.inventory)
+		}
+	}
+	sets := c.ownerSets(inventories)
+
+	start, end := q.page(len(sets))
+	return sets[start:end], nil
+}
+
+// Sets get a list of Set.
+func (c *Catalog) Sets(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Set, error) {
+	q, err := newQuery(opts, withParams(listParams, "theme_id", "min_year", "max_year", "min_parts", "max_parts", "search"))
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []rebrickable.Set
+	for _, setNum := range c.setNums {
+		set := c.sets[setNum]
+		if q.equals("theme_id", set.ThemeID) &&
+			q.between("min_year", "max_year", set.Year) &&
+			q.between("min_parts", "max_parts", set.NumParts) &&
+			q.search(set.SetNum, set.Name) {
+			sets = append(sets, set)
+		}
+	}
+	q.order(sets, map[string]func(i, j int) bool{
+		"set_num":   func(i, j int) bool { return sets[i].SetNum < sets[j].SetNum },
+		"name":      func(i, j int) bool { return sets[i].Name < sets[j].Name },
+		"year":      func(i, j int) bool { return sets[i].Year < sets[j].Year },
+		"theme_id":  func(i, j int) bool { return sets[i].ThemeID < sets[j].ThemeID },
+		"num_parts": func(i, j int) bool { return sets[i].NumParts < sets[j].NumParts },
+	})
+
+	start, end := q.page(len(sets))
+	return sets[start:end], nil
+}
+
+// Set get details for a specific Set.
+func (c *Catalog) Set(ctx context.Context, setNumber string) (rebrickable.Set, error) {
+	set, ok := c.sets[setNumber]
+	if !ok {
+		return set, notFound("set", setNumber)
+	}
+	return set, nil
+}
+
+// SetAlternates MOCs are not part of the CSV downloads, so no
+// alternates are ever returned.
+func (c *Catalog) SetAlternates(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.MOC, error) {
+	if _, ok := c.sets[setNumber]; !ok {
+		return nil, notFound("set", setNumber)
+	}
+	if _, err := newQuery(opts, listParams); err != nil {
+		return nil, err
+	}
+	return []rebrickable.MOC{}, nil
+}
+
+// SetMinifigs get a list of all InventoryMinifig in this Set.
+func (c *Catalog) SetMinifigs(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.InventoryMinifig, error) {
+	if _, ok := c.sets[setNumber]; !ok {
+		return nil, notFound("set", setNumber)
+	}
+	q, err := newQuery(opts, pageParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var minifigs []rebrickable.InventoryMinifig
+	for _, fig := range c.invMinifigs[c.inventories[setNumber]] {
+		minifig := c.minifigs[fig.num]
+		minifigs = append(minifigs, rebrickable.InventoryMinifig{
+			SetNum:    fig.num,
+			SetName:   minifig.Name,
+			Quantity:  fig.quantity,
+			SetImgURL: minifig.SetImgURL,
+		})
+	}
+
+	start, end := q.page(len(minifigs))
+	return minifigs[start:end], nil
+}
+
+// SetParts get a list of all InventoryPart in this Set.
+func (c *Catalog) SetParts(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.InventoryPart, error) {
+	if _, ok := c.sets[setNumber]; !ok {
+		return nil, notFound("set", setNumber)
+	}
+	q, err := newQuery(opts, withParams(pageParams, "inc_part_details", "inc_color_details", "inc_minifig_parts"))
+	if err != nil {
+		return nil, err
+	}
+
+	parts := c.inventoryParts(setNumber, 1)
+	if q.bool("inc_minifig_parts") {
+		for _, fig := range c.invMinifigs[c.inventories[setNumber]] {
+			parts = append(parts, c.inventoryParts(fig.num, fig.quantity)...)
+		}
+	}
+
+	start, end := q.page(len(parts))
+	return parts[start:end], nil
+}
+
+// SetSets get a list of all InventorySet in this Set.
+func (c *Catalog) SetSets(ctx context.Context, setNumber string, opts ...rebrickable.RequestOption) ([]rebrickable.InventorySet, error) {
+	if _, ok := c.sets[setNumber]; !ok {
+		return nil, notFound("set", setNumber)
+	}
+	q, err := newQuery(opts, pageParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []rebrickable.InventorySet
+	for _, s := range c.invSets[c.inventories[setNumber]] {
+		set := c.sets[s.num]
+		sets = append(sets, rebrickable.InventorySet{
+			SetNum:    s.num,
+			SetName:   set.Name,
+			Quantity:  s.quantity,
+			SetImgURL: set.SetImgURL,
+		})
+	}
+
+	start, end := q.page(len(sets))
+	return sets[start:end], nil
+}
+
+// Themes return all themes
+func (c *Catalog) Themes(ctx context.Context, opts ...rebrickable.RequestOption) ([]rebrickable.Theme, error) {
+	q, err := newQuery(opts, listParams)
+	if err != nil {
+		return nil, err
+	}
+	themes := make([]rebrickable.Theme, 0, len(c.themeIDs))
+	for _, id := range c.themeIDs {
+		themes = append(themes, c.themes[id])
+	}
+	q.order(themes, map[string]func(i, j int) bool{
+		"id":        func(i, j int) bool { return themes[i].ID < themes[j].ID },
+		"name":      func(i, j int) bool { return themes[i].Name < themes[j].Name },
+		"parent_id": func(i, j int) bool { return themes[i].ParentID < themes[j].ParentID },
+	})
+	start, end := q.page(len(themes))
+	return themes[start:end], nil
+}
+
+// Theme get details for a specific Theme.
+func (c *Catalog) Theme(ctx context.Context, id int, opts ...rebrickable.RequestOption) (rebrickable.Theme, error) {
+	if _, err := newQuery(opts, nil); err != nil {
+		return rebrickable.Theme{}, err
+	}
+	theme, ok := c.themes[id]
+	if !ok {
+		return theme, notFound("theme", id)
+	}
+	return theme, nil
+}
+
+// inventoryParts returns the parts in the inventory of the set or
+// minifig num, with each quantity multiplied by quantity.
+func (c *Catalog) inventoryParts(num string, quantity int) []rebrickable.InventoryPart {
+	id, ok := c.inventories[num]
+	if !ok {
+		return []rebrickable.InventoryPart{}
+	}
+
+	parts := make([]rebrickable.InventoryPart, 0, len(c.invParts[id]))
+	for _, p := range c.invParts[id] {
+		part := rebrickable.InventoryPart{
+			Color:    c.colors[p.colorID],
+			SetNum:   num,
+			Quantity: p.quantity * quantity,
+			IsSpare:  p.isSpare,
+		}
+		if partDetails, ok := c.parts[p.partNum]; ok {
+			part.Part = *partDetails
+		} else {
+			part.Part.PartNum = p.partNum
+		}
+		if elements := c.partColorElements[partColor{p.partNum, p.colorID}]; len(elements) > 0 {
+			part.ElementID = elements[0]
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// ownerSets returns the distinct sets owning inventories,
+// sorted by set number.
+func (c *Catalog) ownerSets(inventories []int) []rebrickable.Set {
+	seen := make(map[string]bool)
+	sets := []rebrickable.Set{}
+	for _, id := range inventories {
+		set, ok := c.sets[c.owners[id]]
+		if ok && !seen[set.SetNum] {
+			seen[set.SetNum] = true
+			sets = append(sets, set)
+		}
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i].SetNum < sets[j].SetNum })
+	return sets
+}
+
+func splitComma(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
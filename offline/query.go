@@ -0,0 +1,151 @@
+package offline
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thelolagemann/go-rebrickable"
+)
+
+// defaultPageSize is the page size used by the API when
+// none is requested.
+const defaultPageSize = 100
+
+// The parameters shared by list methods.
+var (
+	pageParams = []string{"page", "page_size"}
+	listParams = withParams(pageParams, "ordering")
+)
+
+// withParams returns a copy of base extended with params.
+func withParams(base []string, params ...string) []string {
+	return append(append([]string(nil), base...), params...)
+}
+
+// query holds the parameters set by a list of RequestOption,
+// which are applied to a request which is never sent.
+type query struct {
+	url.Values
+}
+
+// newQuery returns the parameters set by opts, or an error wrapping
+// rebrickable.ErrUnsupported if any is not one of accepted.
+func newQuery(opts []rebrickable.RequestOption, accepted []string) (query, error) {
+	req := &http.Request{URL: &url.URL{}, Header: http.Header{}}
+	for _, opt := range opts {
+		opt(req)
+	}
+	q := query{req.URL.Query()}
+
+	params := make([]string, 0, len(q.Values))
+	for param := range q.Values {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+	for _, param := range params {
+		if !contains(accepted, param) {
+			return q, fmt.Errorf("offline: the %q option: %w", param, rebrickable.ErrUnsupported)
+		}
+	}
+	return q, nil
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// int returns the value of the integer parameter name, and
+// whether it was set.
+func (q query) int(name string) (int, bool) {
+	v, err := strconv.Atoi(q.Get(name))
+	return v, err == nil
+}
+
+// bool returns the value of the boolean parameter name.
+func (q query) bool(name string) bool {
+	v := q.Get(name)
+	return v == "1" || strings.EqualFold(v, "true")
+}
+
+// between reports whether v is within the range set by
+// the parameters min and max, if any.
+func (q query) between(min, max string, v int) bool {
+	if lo, ok := q.int(min); ok && v < lo {
+		return false
+	}
+	if hi, ok := q.int(max); ok && v > hi {
+		return false
+	}
+	return true
+}
+
+// equals reports whether v equals the integer parameter
+// name, if it was set.
+func (q query) equals(name string, v int) bool {
+	want, ok := q.int(name)
+	return !ok || want == v
+}
+
+// search reports whether any of values contains the search
+// term, if one was set.
+func (q query) search(values ...string) bool {
+	term := strings.ToLower(q.Get("search"))
+	if term == "" {
+		return true
+	}
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), term) {
+			return true
+		}
+	}
+	return false
+}
+
+// page returns the bounds of the requested page of n results.
+func (q query) page(n int) (start, end int) {
+	size, ok := q.int("page_size")
+	if !ok || size < 1 {
+		size = defaultPageSize
+	}
+	page, ok := q.int("page")
+	if !ok || page < 1 {
+		page = 1
+	}
+
+	start = (page - 1) * size
+	if start > n {
+		start = n
+	}
+	end = start + size
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// order sorts slice by the field set by the ordering parameter,
+// using fields to look up how to compare two elements by field.
+// A field prefixed with - sorts in descending order.
+func (q query) order(slice interface{}, fields map[string]func(i, j int) bool) {
+	field := q.Get("ordering")
+	desc := strings.HasPrefix(field, "-")
+	less, ok := fields[strings.TrimPrefix(field, "-")]
+	if !ok {
+		return
+	}
+	sort.SliceStable(slice, func(i, j int) bool {
+		if desc {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+}
@@ -0,0 +1,277 @@
+package rebrickable
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type RequestOption func(*http.Request)
+
+// Page a page number within the paginated
+// result set.
+func Page(pageNumber int) RequestOption {
+	return paramRequest("page", fmt.Sprint(pageNumber))
+}
+
+// PageSize number of results to return per page
+func PageSize(size int) RequestOption {
+	return paramRequest("page_size", fmt.Sprint(size))
+}
+
+// Ordering the field to use when ordering the results.
+func Ordering(order string) RequestOption {
+	return paramRequest("ordering", order)
+}
+
+// Search a search term to filter the results by.
+func Search(term string) RequestOption {
+	return paramRequest("search", term)
+}
+
+// ThemeID only return results belonging to the Theme.
+func ThemeID(id int) RequestOption {
+	return paramRequest("theme_id", fmt.Sprint(id))
+}
+
+// MinYear only return results released in or after year.
+func MinYear(year int) RequestOption {
+	return paramRequest("min_year", fmt.Sprint(year))
+}
+
+// MaxYear only return results released in or before year.
+func MaxYear(year int) RequestOption {
+	return paramRequest("max_year", fmt.Sprint(year))
+}
+
+// MinParts only return results with at least n parts.
+func MinParts(n int) RequestOption {
+	return paramRequest("min_parts", fmt.Sprint(n))
+}
+
+// MaxParts only return results with at most n parts.
+func MaxParts(n int) RequestOption {
+	return paramRequest("max_parts", fmt.Sprint(n))
+}
+
+// InSetNum only return Minifig found in the Set.
+func InSetNum(setNumber string) RequestOption {
+	return paramRequest("in_set_num", setNumber)
+}
+
+// InThemeID only return Minifig found in sets of the Theme.
+func InThemeID(id int) RequestOption {
+	return paramRequest("in_theme_id", fmt.Sprint(id))
+}
+
+// SetNum only return the Set with the set number.
+func SetNum(setNumber string) RequestOption {
+	return paramRequest("set_num", setNumber)
+}
+
+// FigSetNum only return the Minifig with the set number.
+func FigSetNum(setNumber string) RequestOption {
+	return paramRequest("fig_set_num", setNumber)
+}
+
+// PartNum only return the Part with the part number.
+func PartNum(partNumber string) RequestOption {
+	return paramRequest("part_num", partNumber)
+}
+
+// PartNums only return Part matching one of the part numbers.
+func PartNums(partNumbers ...string) RequestOption {
+	return paramRequest("part_nums", strings.Join(partNumbers, ","))
+}
+
+// PartCatID only return Part in the PartCategory.
+func PartCatID(id int) RequestOption {
+	return paramRequest("part_cat_id", fmt.Sprint(id))
+}
+
+// ColorID only return Part which have appeared in the Color.
+func ColorID(id int) RequestOption {
+	return paramRequest("color_id", fmt.Sprint(id))
+}
+
+// BrickLinkID only return Part with the BrickLink part number.
+func BrickLinkID(id string) RequestOption {
+	return paramRequest("bricklink_id", id)
+}
+
+// BrickOwlID only return Part with the BrickOwl ID.
+func BrickOwlID(id string) RequestOption {
+	return paramRequest("brickowl_id", id)
+}
+
+// LEGOID only return Part with the LEGO design ID.
+func LEGOID(id string) RequestOption {
+	return paramRequest("lego_id", id)
+}
+
+// LDrawID only return Part with the LDraw part number.
+func LDrawID(id string) RequestOption {
+	return paramRequest("ldraw_id", id)
+}
+
+// IncPartDetails whether to include the full Part details
+// (prints, molds, alternates) in the results.
+func IncPartDetails(include bool) RequestOption {
+	return boolParamRequest("inc_part_details", include)
+}
+
+// IncColorDetails whether to include the full Color details
+// in the results.
+func IncColorDetails(include bool) RequestOption {
+	return boolParamRequest("inc_color_details", include)
+}
+
+// IncMinifigParts whether to include the parts of any
+// Minifig in a Set inventory.
+func IncMinifigParts(include bool) RequestOption {
+	return boolParamRequest("inc_minifig_parts", include)
+}
+
+func boolParamRequest(param string, value bool) RequestOption {
+	if value {
+		return paramRequest(param, "1")
+	}
+	return paramRequest(param, "0")
+}
+
+func paramRequest(param, value string) RequestOption {
+	return func(r *http.Request) {
+		q := r.URL.Query()
+		q.Set(param, value)
+		r.URL.RawQuery = q.Encode()
+	}
+}
+
+var (
+	pageParams = []string{"page", "page_size"}
+	listParams = withParams(pageParams, "ordering")
+
+	userSetsParams = withParams(listParams, "set_num", "theme_id", "min_year", "max_year", "search")
+)
+
+// withParams returns a copy of base extended with params.
+func withParams(base []string, params ...string) []string {
+	return append(append([]string(nil), base...), params...)
+}
+
+// endpointParams maps each endpoint, with path parameters replaced
+// by *, to the query parameters it accepts.
+var endpointParams = map[string][]string{
+	"lego/colors":                listParams,
+	"lego/colors/*":              nil,
+	"lego/elements/*":            nil,
+	"lego/minifigs":              withParams(listParams, "min_parts", "max_parts", "in_set_num", "in_theme_id", "search"),
+	"lego/minifigs/*":            nil,
+	"lego/minifigs/*/parts":      pageParams,
+	"lego/minifigs/*/sets":       pageParams,
+	"lego/mocs/*":                nil,
+	"lego/mocs/*/parts":          pageParams,
+	"lego/part_categories":       listParams,
+	"lego/part_categories/*":     nil,
+	"lego/parts":                 withParams(pageParams, "part_num", "part_nums", "part_cat_id", "color_id", "bricklink_id", "brickowl_id", "lego_id", "ldraw_id", "search", "inc_part_details"),
+	"lego/parts/*":               nil,
+	"lego/parts/*/colors":        pageParams,
+	"lego/parts/*/colors/*":      nil,
+	"lego/parts/*/colors/*/sets": pageParams,
+	"lego/sets":                  withParams(listParams, "theme_id", "min_year", "max_year", "min_parts", "max_parts", "search"),
+	"lego/sets/*":                nil,
+	"lego/sets/*/alternates":     listParams,
+	"lego/sets/*/minifigs":       pageParams,
+	"lego/sets/*/parts":          withParams(pageParams, "inc_part_details", "inc_color_details", "inc_minifig_parts"),
+	"lego/sets/*/sets":           pageParams,
+	"lego/themes":                listParams,
+	"lego/themes/*":              nil,
+
+	"users/*/allparts":              withParams(listParams, "part_num", "part_cat_id", "color_id"),
+	"users/*/build/*":               nil,
+	"users/*/lost_parts":            listParams,
+	"users/*/lost_parts/*":          nil,
+	"users/*/minifigs":              withParams(listParams, "fig_set_num", "search"),
+	"users/*/partlists":             pageParams,
+	"users/*/partlists/*":           nil,
+	"users/*/partlists/*/parts":     listParams,
+	"users/*/partlists/*/parts/*/*": nil,
+	"users/*/profile":               nil,
+	"users/*/parts":                 withParams(listParams, "part_num", "part_cat_id", "color_id"),
+	"users/*/setlists":              pageParams,
+	"users/*/setlists/*":            nil,
+	"users/*/setlists/*/sets":       listParams,
+	"users/*/setlists/*/sets/*":     nil,
+	"users/*/sets":                  userSetsParams,
+	"users/*/sets/*":                nil,
+}
+
+// validateParams returns an error if query contains a parameter
+// which is not accepted by endpoint. Endpoints which are not
+// known, such as absolute next links, are not validated.
+func validateParams(endpoint string, query map[string][]string) error {
+	accepted, ok := lookupParams(endpoint)
+	if !ok {
+		return nil
+	}
+
+	params := make([]string, 0, len(query))
+	for param := range query {
+		params = append(params, param)
+	}
+	sort.Strings(params)
+
+	for _, param := range params {
+		if !containsString(accepted, param) {
+			return fmt.Errorf("rebrickable: %v does not accept the %q option", endpoint, param)
+		}
+	}
+
+	return nil
+}
+
+func lookupParams(endpoint string) ([]string, bool) {
+	// next links carry their query in the endpoint
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	for pattern, params := range endpointParams {
+		if matchSegments(strings.Split(pattern, "/"), segments) {
+			return params, true
+		}
+	}
+	return nil, false
+}
+
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) != len(segments) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// moreSpecific reports whether the pattern a should be preferred over
+// b when both match an endpoint, i.e. it has fewer wildcards. Ties are
+// broken by name, so the choice doesn't depend on map order.
+func moreSpecific(a, b string) bool {
+	if wa, wb := strings.Count(a, "*"), strings.Count(b, "*"); wa != wb {
+		return wa < wb
+	}
+	return a < b
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
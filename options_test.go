@@ -0,0 +1,41 @@
+package rebrickable
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRequestOption(t *testing.T) {
+	t.Run("Params", func(t *testing.T) {
+		var query string
+		globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			return mockResponse(req)
+		}
+		defer func() { globalMock.mockDo = mockResponse }()
+
+		if _, err := client.Sets(ctx, Page(2), PageSize(4), ThemeID(1), MinYear(2000), Search("claas")); err != nil {
+			t.Fatal(err)
+		}
+		if expected := "min_year=2000&page=2&page_size=4&search=claas&theme_id=1"; query != expected {
+			t.Errorf("expected query %q, got %q", expected, query)
+		}
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		if _, err := client.Colors(ctx, ThemeID(1)); err == nil {
+			t.Error("expected theme_id to be rejected by lego/colors")
+		}
+		if _, err := client.Color(ctx, 212, Page(1)); err == nil {
+			t.Error("expected page to be rejected by lego/colors/212")
+		}
+		if _, err := client.SetParts(ctx, "42102-1", IncMinifigParts(true), PageSize(10)); err != nil {
+			t.Error(err)
+		}
+		if _, err := user.Sets(ctx, IncPartDetails(true)); err == nil {
+			t.Error("expected inc_part_details to be rejected by users/{user_token}/sets")
+		}
+		if err := validateParams(user.endpoint("sets/"), map[string][]string{"theme_id": {"1"}}); err != nil {
+			t.Error(err)
+		}
+	})
+}
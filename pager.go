@@ -0,0 +1,139 @@
+package rebrickable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// ErrNoMorePages is returned by Pager.Next once every page
+// has been fetched.
+var ErrNoMorePages = errors.New("rebrickable: no more pages")
+
+// Pager iterates over the pages of a paginated endpoint, lazily
+// following the next link returned with each page. A Pager is
+// not safe for concurrent use.
+type Pager struct {
+	c     *Client
+	next  string
+	opts  []RequestOption
+	count int
+}
+
+func (c *Client) newPager(endpoint string, opts ...RequestOption) *Pager {
+	return &Pager{c: c, next: endpoint, opts: opts}
+}
+
+// Count returns the total number of results across all pages, as
+// reported by the API. It is zero until the first page is fetched.
+func (p *Pager) Count() int {
+	return p.count
+}
+
+// More reports whether there are pages left to fetch.
+func (p *Pager) More() bool {
+	return p.next != ""
+}
+
+// Next fetches the next page and decodes its results into dest,
+// which must be a pointer to a slice of the endpoint's result type.
+// ErrNoMorePages is returned once the last page has been fetched.
+func (p *Pager) Next(ctx context.Context, dest interface{}) error {
+	if !p.More() {
+		return ErrNoMorePages
+	}
+
+	var page PaginatedResponse
+	if err := p.c.get(ctx, p.next, false, &page, p.opts...); err != nil {
+		return err
+	}
+
+	// the next link already carries the query parameters
+	// of the original request
+	next, err := p.c.nextEndpoint(page.Next)
+	if err != nil {
+		p.next = ""
+		return err
+	}
+	p.count, p.next, p.opts = page.Count, next, nil
+
+	return decodeResults(page, dest)
+}
+
+// nextEndpoint returns the path and query of a next link relative to
+// the client's base URL, so the API key is never sent to the host the
+// link names.
+func (c *Client) nextEndpoint(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	base, err := url.Parse(c.url)
+	if err != nil {
+		return "", err
+	}
+
+	// the API builds links from its own path, while a proxy or test
+	// server may build them from the base path it is mounted at
+	var endpoint string
+	switch {
+	case strings.HasPrefix(u.Path, apiPath):
+		endpoint = strings.TrimPrefix(u.Path, apiPath)
+	case u.Host == base.Host && strings.HasPrefix(u.Path, base.Path):
+		endpoint = strings.TrimPrefix(u.Path, base.Path)
+	default:
+		return "", fmt.Errorf("rebrickable: unexpected next link %q", next)
+	}
+
+	if u.RawQuery != "" {
+		endpoint += "?" + u.RawQuery
+	}
+	return endpoint, nil
+}
+
+// All fetches every remaining page, appending the results to dest,
+// which must be a pointer to a slice of the endpoint's result type.
+func (p *Pager) All(ctx context.Context, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return errors.New("rebrickable: dest must be a pointer to a slice")
+	}
+	all := v.Elem()
+
+	for p.More() {
+		page := reflect.New(all.Type())
+		if err := p.Next(ctx, page.Interface()); err != nil {
+			return err
+		}
+		all = reflect.AppendSlice(all, page.Elem())
+	}
+	v.Elem().Set(all)
+
+	return nil
+}
+
+// eachPage calls fetch with the options of each page of a Catalog
+// list, from the first, until fetch returns fewer results than a
+// full page or an error.
+func eachPage(fetch func(opts ...RequestOption) (int, error)) error {
+	const pageSize = 1000
+
+	for page := 1; ; page++ {
+		n, err := fetch(Page(page), PageSize(pageSize))
+		if errors.Is(err, ErrNotFound) && page > 1 {
+			// the API has no page after a full last page
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if n < pageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package rebrickable
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPager(t *testing.T) {
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host != "rebrickable.com" {
+			return nil, fmt.Errorf("request sent to %v", req.URL.Host)
+		}
+		next := `"https://elsewhere.example/api/v3/lego/themes/?page=2"`
+		results := `[{"id": 1, "parent_id": null, "name": "Technic"}]`
+		if req.URL.Query().Get("page") == "2" {
+			next = "null"
+			results = `[{"id": 3, "parent_id": 1, "name": "Competition"}]`
+		}
+		return mockClientResponse(200, fmt.Sprintf(
+			`{"count": 2, "next": %v, "previous": null, "results": %v}`, next, results)), nil
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	t.Run("Next", func(t *testing.T) {
+		pager := client.ThemesPager()
+		var themes []Theme
+		for pager.More() {
+			if err := pager.Next(ctx, &themes); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if pager.Count() != 2 || len(themes) != 1 || themes[0].ID != 3 {
+			t.Errorf("unexpected final page: count %v, themes %v", pager.Count(), themes)
+		}
+		if err := pager.Next(ctx, &themes); !errors.Is(err, ErrNoMorePages) {
+			t.Errorf("expected ErrNoMorePages, got: %v", err)
+		}
+	})
+	t.Run("UnexpectedNext", func(t *testing.T) {
+		if _, err := client.nextEndpoint("https://rebrickable.com/other/?page=2"); err == nil {
+			t.Error("expected error")
+		}
+		if endpoint, err := client.nextEndpoint("/api/v3/lego/themes/?page=2"); err != nil || endpoint != "lego/themes/?page=2" {
+			t.Errorf("unexpected endpoint %q: %v", endpoint, err)
+		}
+	})
+	t.Run("All", func(t *testing.T) {
+		var themes []Theme
+		if err := client.ThemesPager().All(ctx, &themes); err != nil {
+			t.Fatal(err)
+		}
+		if len(themes) != 2 || themes[0].ID != 1 || themes[1].ID != 3 {
+			t.Errorf("expected both pages, got: %v", themes)
+		}
+	})
+}
+
+func TestPager_RootMounted(t *testing.T) {
+	for name, link := range map[string]string{
+		"APILink":    "https://rebrickable.com/api/v3/lego/themes/?page=2",
+		"ServerLink": "/lego/themes/?page=2",
+	} {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				next := `"` + link + `"`
+				if link[0] == '/' {
+					next = `"` + server.URL + link + `"`
+				}
+				results := `[{"id": 1, "parent_id": null, "name": "Technic"}]`
+				if r.URL.Query().Get("page") == "2" {
+					next = "null"
+					results = `[{"id": 3, "parent_id": 1, "name": "Competition"}]`
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"count": 2, "next": %v, "previous": null, "results": %v}`, next, results)
+			}))
+			defer server.Close()
+
+			var themes []Theme
+			c := NewClient("", BaseURL(server.URL))
+			if err := c.ThemesPager().All(ctx, &themes); err != nil {
+				t.Fatal(err)
+			}
+			if len(themes) != 2 || len(paths) != 2 || paths[0] != "/lego/themes" || paths[1] != "/lego/themes/" {
+				t.Errorf("unexpected themes %v from paths %v", themes, paths)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package rebrickable
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// PartLists get a list of all the user's PartList.
+func (u *UserClient) PartLists(ctx context.Context, opts ...RequestOption) (partLists []PartList, err error) {
+	err = u.c.get(ctx, u.endpoint("partlists/"), true, &partLists, opts...)
+	return
+}
+
+// PartListsPager returns a Pager over every page of PartLists, each
+// page decoding into a []PartList.
+func (u *UserClient) PartListsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("partlists/"), opts...)
+}
+
+// PartList get details about a specific PartList.
+func (u *UserClient) PartList(ctx context.Context, id int) (partList PartList, err error) {
+	err = u.c.get(ctx, u.endpoint("partlists/%v/", id), false, &partList)
+	return
+}
+
+// CreatePartList create a new, empty PartList.
+func (u *UserClient) CreatePartList(ctx context.Context, name string, isBuildable bool) (partList PartList, err error) {
+	form := url.Values{
+		"name":         {name},
+		"is_buildable": {fmt.Sprint(isBuildable)},
+	}
+	err = u.c.post(ctx, u.endpoint("partlists/"), form, &partList)
+	return
+}
+
+// RenamePartList change the name of a specific PartList.
+func (u *UserClient) RenamePartList(ctx context.Context, id int, name string) (partList PartList, err error) {
+	err = u.c.patch(ctx, u.endpoint("partlists/%v/", id), url.Values{"name": {name}}, &partList)
+	return
+}
+
+// DeletePartList delete a specific PartList and all the parts in it.
+func (u *UserClient) DeletePartList(ctx context.Context, id int) error {
+	return u.c.delete(ctx, u.endpoint("partlists/%v/", id))
+}
+
+type PartList struct {
+	ID          int    `json:"id"`
+	IsBuildable bool   `json:"is_buildable"`
+	Name        string `json:"name"`
+	NumParts    int    `json:"num_parts"`
+}
+
+// PartListParts get a list of all the PartListPart in a specific PartList.
+func (u *UserClient) PartListParts(ctx context.Context, id int, opts ...RequestOption) (parts []PartListPart, err error) {
+	err = u.c.get(ctx, u.endpoint("partlists/%v/parts/", id), true, &parts, opts...)
+	return
+}
+
+// PartListPartsPager returns a Pager over every page of PartListParts,
+// each page decoding into a []PartListPart.
+func (u *UserClient) PartListPartsPager(id int, opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("partlists/%v/parts/", id), opts...)
+}
+
+// PartListPart get details about a specific Part Color combination
+// in a PartList.
+func (u *UserClient) PartListPart(ctx context.Context, id int, partNumber string, colorId int) (part PartListPart, err error) {
+	err = u.c.get(ctx, u.endpoint("partlists/%v/parts/%v/%v/", id, url.PathEscape(partNumber), colorId), false, &part)
+	return
+}
+
+// AddPartListPart add quantity of a Part Color combination to a PartList.
+func (u *UserClient) AddPartListPart(ctx context.Context, id int, partNumber string, colorId, quantity int) (part PartListPart, err error) {
+	form := url.Values{
+		"part_num": {partNumber},
+		"color_id": {fmt.Sprint(colorId)},
+		"quantity": {fmt.Sprint(quantity)},
+	}
+	err = u.c.post(ctx, u.endpoint("partlists/%v/parts/", id), form, &part)
+	return
+}
+
+// AddPartListParts add several Part Color combinations to a PartList
+// in a single request. Only the part number, color ID and quantity
+// of each PartListPart are used.
+func (u *UserClient) AddPartListParts(ctx context.Context, id int, parts []PartListPart) (added []PartListPart, err error) {
+	type bulkPart struct {
+		PartNum  string `json:"part_num"`
+		ColorID  int    `json:"color_id"`
+		Quantity int    `json:"quantity"`
+	}
+	body := make([]bulkPart, len(parts))
+	for i, p := range parts {
+		body[i] = bulkPart{p.Part.PartNum, p.Color.ID, p.Quantity}
+	}
+	err = u.c.jsonRequest(ctx, "POST", u.endpoint("partlists/%v/parts/", id), body, &added)
+	return
+}
+
+// UpdatePartListPart set the quantity of a Part Color combination
+// in a PartList.
+func (u *UserClient) UpdatePartListPart(ctx context.Context, id int, partNumber string, colorId, quantity int) (part PartListPart, err error) {
+	form := url.Values{"quantity": {fmt.Sprint(quantity)}}
+	err = u.c.put(ctx, u.endpoint("partlists/%v/parts/%v/%v/", id, url.PathEscape(partNumber), colorId), form, &part)
+	return
+}
+
+// DeletePartListPart remove a Part Color combination from a PartList.
+func (u *UserClient) DeletePartListPart(ctx context.Context, id int, partNumber string, colorId int) error {
+	return u.c.delete(ctx, u.endpoint("partlists/%v/parts/%v/%v/", id, url.PathEscape(partNumber), colorId))
+}
+
+type PartListPart struct {
+	ListID   int   `json:"list_id"`
+	Quantity int   `json:"quantity"`
+	Part     Part  `json:"part"`
+	Color    Color `json:"color"`
+}
+
+// InventoryPart returns the PartListPart as an InventoryPart.
+func (p PartListPart) InventoryPart() InventoryPart {
+	return InventoryPart{Part: p.Part, Color: p.Color, Quantity: p.Quantity}
+}
+
+// PartListPart returns the InventoryPart as a PartListPart, e.g. to
+// add it to a PartList with AddPartListParts.
+func (p InventoryPart) PartListPart() PartListPart {
+	return PartListPart{Part: p.Part, Color: p.Color, Quantity: p.Quantity}
+}
@@ -0,0 +1,57 @@
+package rebrickable
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestUserClient_PartLists(t *testing.T) {
+	t.Run("PartLists", func(t *testing.T) {
+		if partLists, err := user.PartLists(ctx); err != nil {
+			t.Error(err)
+		} else if len(partLists) != 1 || partLists[0].ID != 344498 {
+			t.Errorf("unexpected part lists: %v", partLists)
+		}
+	})
+	t.Run("CreatePartList", func(t *testing.T) {
+		globalMock.mockResponse(201, []byte(`{"id": 1, "is_buildable": true, "name": "Bin 1", "num_parts": 0}`), func() {
+			if partList, err := user.CreatePartList(ctx, "Bin 1", true); err != nil {
+				t.Error(err)
+			} else if partList.Name != "Bin 1" {
+				t.Errorf("unexpected part list: %v", partList)
+			}
+		})
+	})
+	t.Run("DeletePartList", func(t *testing.T) {
+		globalMock.mockResponse(204, nil, func() {
+			if err := user.DeletePartList(ctx, 1); err != nil {
+				t.Error(err)
+			}
+		})
+	})
+}
+
+func TestUserClient_AddPartListParts(t *testing.T) {
+	var method, path, body string
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		b, _ := ioutil.ReadAll(req.Body)
+		method, path, body = req.Method, req.URL.Path, string(b)
+		return mockClientResponse(201, `[]`), nil
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	parts := []PartListPart{
+		{Part: Part{PartNum: "3001"}, Color: Color{ID: 4}, Quantity: 2},
+		{Part: Part{PartNum: "3003"}, Color: Color{ID: 0}, Quantity: 1},
+	}
+	if _, err := user.AddPartListParts(ctx, 1, parts); err != nil {
+		t.Fatal(err)
+	}
+	if method != "POST" || path != "/api/v3/users/arandomtoken/partlists/1/parts/" {
+		t.Errorf("unexpected request: %v %v", method, path)
+	}
+	if expected := `[{"part_num":"3001","color_id":4,"quantity":2},{"part_num":"3003","color_id":0,"quantity":1}]`; body != expected {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
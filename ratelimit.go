@@ -0,0 +1,80 @@
+package rebrickable
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit limit the client to perSecond requests per second,
+// allowing bursts of up to burst requests. The limit is shared by
+// every goroutine using the client, as well as any UserClient
+// created from it. Rebrickable throttles API keys to roughly one
+// request per second, i.e. RateLimit(1, 1). A perSecond of zero or
+// less removes any limit.
+func RateLimit(perSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		if perSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newLimiter(perSecond, burst)
+	}
+}
+
+// limiter is a token bucket rate limiter, safe for concurrent use.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(perSecond float64, burst int) *limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &limiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available, or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+
+	// refill the bucket for the time elapsed since the last call
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// reserve a token, waiting for the bucket to refill if it
+	// has been exhausted by other callers
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	if err := sleep(ctx, delay); err != nil {
+		// give back the reserved token
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
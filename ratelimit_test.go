@@ -0,0 +1,59 @@
+package rebrickable
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimit(t *testing.T) {
+	c := NewClient("", HTTPClient(globalMock), RateLimit(50, 2))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Theme(ctx, 3); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 2 requests are allowed by the burst, the remaining
+	// 4 must wait 20ms each
+	if elapsed := time.Since(start); elapsed < 70*time.Millisecond {
+		t.Errorf("expected requests to be limited, took %v", elapsed)
+	}
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	for _, perSecond := range []float64{0, -1} {
+		c := NewClient("", HTTPClient(globalMock), RateLimit(perSecond, 1))
+		if c.limiter != nil {
+			t.Errorf("expected no limiter for %v per second", perSecond)
+		}
+		for i := 0; i < 3; i++ {
+			if _, err := c.Colors(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestLimiter_Context(t *testing.T) {
+	l := newLimiter(1, 1)
+	if err := l.wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(timeout); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package rebrickable
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,23 +12,29 @@ import (
 	"strings"
 )
 
-const baseURL = "https://rebrickable.com/api/v3/"
+const (
+	baseURL = "https://rebrickable.com/api/v3/"
+	apiPath = "/api/v3/"
+)
 
 type httpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
 type Client struct {
-	url string
-	key string
+	url     string
+	key     string
+	limiter *limiter
+	retry   *RetryPolicy
+	cache   *responseCache
 	httpClient
 }
 
 func NewClient(apiKey string, opts ...ClientOption) *Client {
 	c := &Client{
-		baseURL,
-		apiKey,
-		&http.Client{},
+		url:        baseURL,
+		key:        apiKey,
+		httpClient: &http.Client{},
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -35,8 +42,13 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 	return c
 }
 
-func (c *Client) newRequest(method, endpoint string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
-	req, err := http.NewRequest(method, fmt.Sprintf("%v%v", baseURL, endpoint), body)
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
+	u, err := resolve(c.url, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -48,21 +60,73 @@ func (c *Client) newRequest(method, endpoint string, body io.Reader, opts ...Req
 	for _, opt := range opts {
 		opt(req)
 	}
+	if err := validateParams(endpoint, req.URL.Query()); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-func (c *Client) delete(endpoint string, opts ...RequestOption) error {
-	req, err := c.newRequest("DELETE", endpoint, nil, opts...)
+// resolve resolves endpoint against base. Absolute endpoints,
+// such as the next link of a paginated response, are returned
+// as they are.
+func resolve(base, endpoint string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	e, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	return b.ResolveReference(e).String(), nil
+}
+
+// do executes req, retrying it according to the client's
+// RetryPolicy if one is set.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if c.retry != nil {
+		return c.retry.do(req, c.doOnce)
+	}
+	return c.doOnce(req)
+}
+
+// doOnce executes req once the rate limiter allows it, returning
+// an *APIError if the API responds with a non 2xx status code.
+func (c *Client) doOnce(req *http.Request) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 304 is only returned to conditional requests made
+	// when revalidating a cached response
+	if (res.StatusCode < 200 || res.StatusCode >= 300) && res.StatusCode != http.StatusNotModified {
+		defer res.Body.Close()
+		return nil, newAPIError(req, res)
+	}
+
+	return res, nil
+}
+
+func (c *Client) delete(ctx context.Context, endpoint string, opts ...RequestOption) error {
+	req, err := c.newRequest(ctx, "DELETE", endpoint, nil, opts...)
 	if err != nil {
 		return err
 	}
 
 	// do request
-	res, err := c.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusNoContent {
 		return fmt.Errorf("expected HTTP status code of 204, got: %v", res.StatusCode)
@@ -71,39 +135,39 @@ func (c *Client) delete(endpoint string, opts ...RequestOption) error {
 	return nil
 }
 
-
-func (c *Client) get(endpoint string, paginated bool, dest interface{}, opts ...RequestOption) error {
-	req, err := c.newRequest("GET", endpoint, nil, opts...)
+func (c *Client) get(ctx context.Context, endpoint string, paginated bool, dest interface{}, opts ...RequestOption) error {
+	req, err := c.newRequest(ctx, "GET", endpoint, nil, opts...)
 	if err != nil {
 		return err
 	}
 
-	res, err := c.Do(req)
-
+	res, err := c.cachedDo(req)
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
 	return decodeJSON(res, paginated, dest)
 }
 
-func (c *Client) patch(endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
-	return c.formRequest("PATCH", endpoint, form, dest, opts...)
+func (c *Client) patch(ctx context.Context, endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
+	return c.formRequest(ctx, "PATCH", endpoint, form, dest, opts...)
 }
 
-func (c *Client) post(endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
-	return c.formRequest("POST", endpoint, form, dest, opts...)
+func (c *Client) post(ctx context.Context, endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
+	return c.formRequest(ctx, "POST", endpoint, form, dest, opts...)
 }
 
-func (c *Client) put(endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
-	return c.formRequest("PUT", endpoint, form, dest, opts...)
+func (c *Client) put(ctx context.Context, endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
+	return c.formRequest(ctx, "PUT", endpoint, form, dest, opts...)
 }
 
 // formRequest is a helper function to quickly create and
 // execute an HTTP request supplied with formData. Optionally
-// decoding the result into dest.
-func (c *Client) formRequest(method, endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
-	req, err := c.newRequest(method, endpoint, strings.NewReader(form.Encode()), opts...)
+// decoding the result into dest. The request is bound to ctx,
+// so cancelling ctx aborts the underlying call.
+func (c *Client) formRequest(ctx context.Context, method, endpoint string, form url.Values, dest interface{}, opts ...RequestOption) error {
+	req, err := c.newRequest(ctx, method, endpoint, strings.NewReader(form.Encode()), opts...)
 	if err != nil {
 		return err
 	}
@@ -111,10 +175,11 @@ func (c *Client) formRequest(method, endpoint string, form url.Values, dest inte
 	// set content-type header
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
-	res, err := c.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
 	if dest != nil {
 		return decodeJSON(res, false, dest)
@@ -123,39 +188,67 @@ func (c *Client) formRequest(method, endpoint string, form url.Values, dest inte
 	return nil
 }
 
-func decodeJSON(r *http.Response, paginated bool, dest interface{}) error {
-	if !(r.StatusCode >= 200 && r.StatusCode < 300) {
-		type apiError struct {
-			Detail string `json:"detail"`
-		}
-		// try and decode error msg
-		aErr := apiError{}
-		if err := json.NewDecoder(r.Body).Decode(&aErr); err == nil {
-			return fmt.Errorf("http request not OK: %v", aErr.Detail)
-		} else {
-			return fmt.Errorf("http request not OK: %v", r.StatusCode)
-		}
+// jsonRequest is a helper function to quickly create and
+// execute an HTTP request with body encoded as JSON. Optionally
+// decoding the result into dest.
+func (c *Client) jsonRequest(ctx context.Context, method, endpoint string, body, dest interface{}, opts ...RequestOption) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
 	}
 
+	req, err := c.newRequest(ctx, method, endpoint, bytes.NewReader(b), opts...)
+	if err != nil {
+		return err
+	}
+
+	// set content-type header
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if dest != nil {
+		return decodeJSON(res, false, dest)
+	}
+
+	return nil
+}
+
+func decodeJSON(r *http.Response, paginated bool, dest interface{}) error {
 	if r.Header.Get("Content-Type") != "application/json" {
 		return fmt.Errorf("expecting content-type of application/json, got: %v", r.Header.Get("Content-Type"))
 	}
 
-	dec := json.NewDecoder(r.Body)
-	dec.DisallowUnknownFields()
-
 	// handle paginated response
 	if paginated {
-		var res *PaginatedResponse
-		if err := dec.Decode(&res); err != nil {
+		var res PaginatedResponse
+		if err := decodeStrict(r.Body, &res); err != nil {
 			return err
 		}
-		b, err := json.Marshal(res.Results)
-		if err != nil {
-			return err
-		}
-		dec = json.NewDecoder(bytes.NewReader(b))
+		return decodeResults(res, dest)
+	}
+
+	return decodeStrict(r.Body, dest)
+}
+
+// decodeResults decodes the results of a paginated response into dest.
+func decodeResults(res PaginatedResponse, dest interface{}) error {
+	b, err := json.Marshal(res.Results)
+	if err != nil {
+		return err
 	}
+	return decodeStrict(bytes.NewReader(b), dest)
+}
+
+// decodeStrict decodes the JSON in r into dest, rejecting unknown
+// fields.
+func decodeStrict(r io.Reader, dest interface{}) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
 
 	err := dec.Decode(&dest)
 	if err != nil {
@@ -200,26 +293,13 @@ func HTTPClient(client httpClient) ClientOption {
 	}
 }
 
-type RequestOption func(*http.Request)
-
-// Page a page number within the paginated
-// result set.
-func Page(pageNumber int) RequestOption {
-	return paramRequest("page", fmt.Sprint(pageNumber))
-}
-
-// PageSize number of results to return per page
-func PageSize(size int) RequestOption {
-	return paramRequest("page_size", fmt.Sprint(size))
-}
-
-// Ordering the field to use when ordering the results.
-func Ordering(order string) RequestOption {
-	return paramRequest("ordering", order)
-}
-
-func paramRequest(param, value string) RequestOption {
-	return func(r *http.Request) {
-		r.URL.Query().Add(param, value)
+// BaseURL use u as the base URL for all requests instead of the
+// Rebrickable API, e.g. a caching proxy, local mirror or test server.
+func BaseURL(u string) ClientOption {
+	return func(c *Client) {
+		if !strings.HasSuffix(u, "/") {
+			u += "/"
+		}
+		c.url = u
 	}
 }
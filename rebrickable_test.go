@@ -2,12 +2,16 @@ package rebrickable
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
+	"testing"
 )
 
 var (
@@ -21,7 +25,8 @@ var (
 			data = tData.LEGO[strings.Replace(path, "lego/", "", -1)]
 		} else if strings.Contains(path, "users") {
 			// remove token
-			data = tData.Users[strings.SplitN(path, "/", 3)[2]]
+			segments := strings.SplitN(strings.TrimSuffix(path, "/"), "/", 3)
+			data = tData.Users[segments[len(segments)-1]]
 		} else {
 			panic("unhandled mock path")
 		}
@@ -36,6 +41,7 @@ var (
 		}, nil
 	}
 	client = NewClient("", HTTPClient(globalMock))
+	ctx    = context.Background()
 	tData  testData
 )
 
@@ -59,6 +65,18 @@ func init() {
 	}
 }
 
+// mockClientResponse returns a JSON response with the
+// given status code and body.
+func mockClientResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
 type mockDoFunc func(req *http.Request) (*http.Response, error)
 
 type mockClient struct {
@@ -77,13 +95,7 @@ func (m *mockClient) bypassMock(f func()) {
 }
 
 func (m *mockClient) mockResponse(statusCode int, body []byte, f func()) error {
-	r := &http.Response{
-		StatusCode: statusCode,
-		Header: http.Header{
-			"Content-Type": []string{"application/json"},
-		},
-		Body: ioutil.NopCloser(bytes.NewReader(body)),
-	}
+	r := mockClientResponse(statusCode, string(body))
 
 	m.mockDo = func(req *http.Request) (*http.Response, error) {
 		return r, nil
@@ -93,3 +105,35 @@ func (m *mockClient) mockResponse(statusCode int, body []byte, f func()) error {
 
 	return nil
 }
+
+func TestClient_Context(t *testing.T) {
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		return nil, req.Context().Err()
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	if _, err := client.Colors(cancelled); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got: %v", err)
+	}
+}
+
+func TestClient_BaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/lego/themes/3" {
+			t.Errorf("unexpected path: %v", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(tData.LEGO["themes/3"])
+	}))
+	defer server.Close()
+
+	c := NewClient("", BaseURL(server.URL+"/api/v3"))
+	if theme, err := c.Theme(ctx, 3); err != nil {
+		t.Error(err)
+	} else if theme.Name != "Competition" {
+		t.Errorf("expected Competition, got: %v", theme.Name)
+	}
+}
@@ -0,0 +1,133 @@
+package rebrickable
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Requests are
+// retried on network errors and when the API responds with a status
+// code of 429, 502 or 503, waiting for a jittered, exponentially
+// increasing delay between attempts, or for as long as the API asked
+// to via its Retry-After header if that is longer.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a
+	// request, including the first. Defaults to 3.
+	MaxAttempts int
+
+	// MaxElapsed is the maximum time to spend retrying a request.
+	// A retry which would start after MaxElapsed is not attempted.
+	// Zero means no limit.
+	MaxElapsed time.Duration
+
+	// BaseDelay is the delay before the first retry, doubling with
+	// each subsequent retry. Defaults to 500ms.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. Defaults to 30s.
+	MaxDelay time.Duration
+
+	// Methods are the HTTP methods which may be retried. Defaults
+	// to the idempotent methods GET, HEAD, OPTIONS, PUT and DELETE.
+	Methods []string
+}
+
+// Retry retry failed requests according to policy.
+func Retry(policy RetryPolicy) ClientOption {
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = 3
+	}
+	if policy.BaseDelay == 0 {
+		policy.BaseDelay = 500 * time.Millisecond
+	}
+	if policy.MaxDelay == 0 {
+		policy.MaxDelay = 30 * time.Second
+	}
+	if policy.Methods == nil {
+		policy.Methods = []string{"GET", "HEAD", "OPTIONS", "PUT", "DELETE"}
+	}
+	return func(c *Client) {
+		c.retry = &policy
+	}
+}
+
+// do executes req using do, retrying it according to the policy.
+func (p *RetryPolicy) do(req *http.Request, do func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if !containsString(p.Methods, req.Method) {
+		return do(req)
+	}
+
+	ctx := req.Context()
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		res, err := do(req)
+		if err == nil || attempt >= p.MaxAttempts || ctx.Err() != nil || !retryable(err) {
+			return res, err
+		}
+
+		delay := p.backoff(attempt, err)
+		if p.MaxElapsed > 0 && time.Since(start)+delay > p.MaxElapsed {
+			return res, err
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		// rewind the body for the next attempt
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the delay before retrying after attempt failed
+// with err, using full jitter.
+func (p *RetryPolicy) backoff(attempt int, err error) time.Duration {
+	max := p.BaseDelay << (attempt - 1)
+	if max > p.MaxDelay || max <= 0 {
+		max = p.MaxDelay
+	}
+	delay := time.Duration(rand.Int63n(int64(max) + 1))
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > delay {
+		delay = apiErr.RetryAfter
+	}
+	return delay
+}
+
+// retryable reports whether a request which failed with err
+// may succeed if retried.
+func retryable(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		// network error
+		return true
+	}
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
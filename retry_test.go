@@ -0,0 +1,97 @@
+package rebrickable
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	var attempts int
+	failing := func(statusCode, failures int) mockDoFunc {
+		attempts = 0
+		return func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts <= failures {
+				return mockClientResponse(statusCode, `{"detail": "unavailable"}`), nil
+			}
+			return mockResponse(req)
+		}
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	c := NewClient("", HTTPClient(globalMock), Retry(RetryPolicy{BaseDelay: time.Millisecond}))
+
+	t.Run("Retried", func(t *testing.T) {
+		globalMock.mockDo = failing(503, 2)
+		if _, err := c.Theme(ctx, 3); err != nil {
+			t.Error(err)
+		}
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got %v", attempts)
+		}
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		globalMock.mockDo = failing(502, 5)
+		if _, err := c.Theme(ctx, 3); err == nil {
+			t.Error("expected error")
+		}
+		if attempts != 3 {
+			t.Errorf("expected 3 attempts, got %v", attempts)
+		}
+	})
+	t.Run("NotRetryable", func(t *testing.T) {
+		globalMock.mockDo = failing(404, 1)
+		if _, err := c.Theme(ctx, 3); !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got: %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("expected 1 attempt, got %v", attempts)
+		}
+	})
+	t.Run("Method", func(t *testing.T) {
+		globalMock.mockDo = failing(503, 1)
+		if _, err := user.CreatePartList(ctx, "Bin 1", true); err == nil {
+			t.Error("expected POST not to be retried")
+		}
+	})
+	t.Run("Body", func(t *testing.T) {
+		var forms []string
+		attempts = 0
+		globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if err := req.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			forms = append(forms, req.PostForm.Encode())
+			if attempts == 1 {
+				return mockClientResponse(503, `{}`), nil
+			}
+			return mockClientResponse(200, `{"list_id": 1, "quantity": 2, "part": {}, "color": {}}`), nil
+		}
+		u := c.User("arandomtoken")
+		if _, err := u.UpdatePartListPart(ctx, 1, "3001", 4, 2); err != nil {
+			t.Fatal(err)
+		}
+		if len(forms) != 2 || forms[0] != forms[1] {
+			t.Errorf("expected body to be resent, got: %v", forms)
+		}
+	})
+	t.Run("RetryAfter", func(t *testing.T) {
+		c := NewClient("", HTTPClient(globalMock), Retry(RetryPolicy{MaxElapsed: time.Second}))
+		attempts = 0
+		globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+			attempts++
+			res := mockClientResponse(429, `{}`)
+			res.Header.Set("Retry-After", "60")
+			return res, nil
+		}
+		if _, err := c.Theme(ctx, 3); !errors.Is(err, ErrRateLimited) {
+			t.Errorf("expected ErrRateLimited, got: %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("expected Retry-After to exceed MaxElapsed, got %v attempts", attempts)
+		}
+	})
+}
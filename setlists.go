@@ -0,0 +1,176 @@
+package rebrickable
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// SetLists get a list of all the user's SetList.
+func (u *UserClient) SetLists(ctx context.Context, opts ...RequestOption) (setLists []SetList, err error) {
+	err = u.c.get(ctx, u.endpoint("setlists/"), true, &setLists, opts...)
+	return
+}
+
+// SetListsPager returns a Pager over every page of SetLists, each
+// page decoding into a []SetList.
+func (u *UserClient) SetListsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("setlists/"), opts...)
+}
+
+// SetList get details about a specific SetList.
+func (u *UserClient) SetList(ctx context.Context, id int) (setList SetList, err error) {
+	err = u.c.get(ctx, u.endpoint("setlists/%v/", id), false, &setList)
+	return
+}
+
+// CreateSetList create a new, empty SetList.
+func (u *UserClient) CreateSetList(ctx context.Context, name string, isBuildable bool) (setList SetList, err error) {
+	form := url.Values{
+		"name":         {name},
+		"is_buildable": {fmt.Sprint(isBuildable)},
+	}
+	err = u.c.post(ctx, u.endpoint("setlists/"), form, &setList)
+	return
+}
+
+// RenameSetList change the name of a specific SetList.
+func (u *UserClient) RenameSetList(ctx context.Context, id int, name string) (setList SetList, err error) {
+	err = u.c.patch(ctx, u.endpoint("setlists/%v/", id), url.Values{"name": {name}}, &setList)
+	return
+}
+
+// DeleteSetList delete a specific SetList and all the sets in it.
+func (u *UserClient) DeleteSetList(ctx context.Context, id int) error {
+	return u.c.delete(ctx, u.endpoint("setlists/%v/", id))
+}
+
+type SetList struct {
+	ID          int    `json:"id"`
+	IsBuildable bool   `json:"is_buildable"`
+	Name        string `json:"name"`
+	NumSets     int    `json:"num_sets"`
+}
+
+// SetListSets get a list of all the UserSet in a specific SetList.
+func (u *UserClient) SetListSets(ctx context.Context, id int, opts ...RequestOption) (sets []UserSet, err error) {
+	err = u.c.get(ctx, u.endpoint("setlists/%v/sets/", id), true, &sets, opts...)
+	return
+}
+
+// SetListSetsPager returns a Pager over every page of SetListSets,
+// each page decoding into a []UserSet.
+func (u *UserClient) SetListSetsPager(id int, opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("setlists/%v/sets/", id), opts...)
+}
+
+// SetListSet get details about a specific Set in a SetList.
+func (u *UserClient) SetListSet(ctx context.Context, id int, setNumber string) (set UserSet, err error) {
+	err = u.c.get(ctx, u.endpoint("setlists/%v/sets/%v/", id, url.PathEscape(setNumber)), false, &set)
+	return
+}
+
+// AddSetListSet add quantity of a Set to a SetList.
+func (u *UserClient) AddSetListSet(ctx context.Context, id int, setNumber string, quantity int, includeSpares bool) (set UserSet, err error) {
+	err = u.c.post(ctx, u.endpoint("setlists/%v/sets/", id), userSetForm(setNumber, quantity, includeSpares), &set)
+	return
+}
+
+// AddSetListSets add several Set to a SetList in a single request.
+// Only the set number, quantity and include spares fields of each
+// UserSet are used.
+func (u *UserClient) AddSetListSets(ctx context.Context, id int, sets []UserSet) (added []UserSet, err error) {
+	err = u.c.jsonRequest(ctx, "POST", u.endpoint("setlists/%v/sets/", id), bulkUserSets(sets), &added)
+	return
+}
+
+// UpdateSetListSet set the quantity and whether to include the
+// spare parts of a Set in a SetList.
+func (u *UserClient) UpdateSetListSet(ctx context.Context, id int, setNumber string, quantity int, includeSpares bool) (set UserSet, err error) {
+	form := userSetForm("", quantity, includeSpares)
+	err = u.c.put(ctx, u.endpoint("setlists/%v/sets/%v/", id, url.PathEscape(setNumber)), form, &set)
+	return
+}
+
+// DeleteSetListSet remove a Set from a SetList.
+func (u *UserClient) DeleteSetListSet(ctx context.Context, id int, setNumber string) error {
+	return u.c.delete(ctx, u.endpoint("setlists/%v/sets/%v/", id, url.PathEscape(setNumber)))
+}
+
+// Sets get a list of all the UserSet in all the user's set lists.
+func (u *UserClient) Sets(ctx context.Context, opts ...RequestOption) (sets []UserSet, err error) {
+	err = u.c.get(ctx, u.endpoint("sets/"), true, &sets, opts...)
+	return
+}
+
+// SetsPager returns a Pager over every page of Sets, each page
+// decoding into a []UserSet.
+func (u *UserClient) SetsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("sets/"), opts...)
+}
+
+// Set get details about a specific Set in the user's set lists.
+func (u *UserClient) Set(ctx context.Context, setNumber string) (set UserSet, err error) {
+	err = u.c.get(ctx, u.endpoint("sets/%v/", url.PathEscape(setNumber)), false, &set)
+	return
+}
+
+// AddSet add quantity of a Set to the user's default SetList.
+func (u *UserClient) AddSet(ctx context.Context, setNumber string, quantity int, includeSpares bool) (set UserSet, err error) {
+	err = u.c.post(ctx, u.endpoint("sets/"), userSetForm(setNumber, quantity, includeSpares), &set)
+	return
+}
+
+// UpdateSet set the quantity and whether to include the spare parts
+// of a Set across all the user's set lists.
+func (u *UserClient) UpdateSet(ctx context.Context, setNumber string, quantity int, includeSpares bool) (set UserSet, err error) {
+	form := userSetForm("", quantity, includeSpares)
+	err = u.c.put(ctx, u.endpoint("sets/%v/", url.PathEscape(setNumber)), form, &set)
+	return
+}
+
+// DeleteSet remove a Set from all the user's set lists.
+func (u *UserClient) DeleteSet(ctx context.Context, setNumber string) error {
+	return u.c.delete(ctx, u.endpoint("sets/%v/", url.PathEscape(setNumber)))
+}
+
+// SyncSets replace the user's entire set collection with sets. Any
+// Set the user owns which is not in sets is removed, and any Set in
+// sets the user does not own is added to their default SetList. Only
+// the set number, quantity and include spares fields of each UserSet
+// are used.
+func (u *UserClient) SyncSets(ctx context.Context, sets []UserSet) error {
+	return u.c.jsonRequest(ctx, "POST", u.endpoint("sets/sync/"), bulkUserSets(sets), nil)
+}
+
+type UserSet struct {
+	ListID        int  `json:"list_id"`
+	Quantity      int  `json:"quantity"`
+	IncludeSpares bool `json:"include_spares"`
+	Set           Set  `json:"set"`
+}
+
+func userSetForm(setNumber string, quantity int, includeSpares bool) url.Values {
+	form := url.Values{
+		"quantity":       {fmt.Sprint(quantity)},
+		"include_spares": {fmt.Sprint(includeSpares)},
+	}
+	if setNumber != "" {
+		form.Set("set_num", setNumber)
+	}
+	return form
+}
+
+type bulkUserSet struct {
+	SetNum        string `json:"set_num"`
+	Quantity      int    `json:"quantity"`
+	IncludeSpares bool   `json:"include_spares"`
+}
+
+func bulkUserSets(sets []UserSet) []bulkUserSet {
+	body := make([]bulkUserSet, len(sets))
+	for i, s := range sets {
+		body[i] = bulkUserSet{s.Set.SetNum, s.Quantity, s.IncludeSpares}
+	}
+	return body
+}
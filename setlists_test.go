@@ -0,0 +1,70 @@
+package rebrickable
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestUserClient_SetLists(t *testing.T) {
+	t.Run("CreateSetList", func(t *testing.T) {
+		globalMock.mockResponse(201, []byte(`{"id": 2, "is_buildable": true, "name": "Warehouse", "num_sets": 0}`), func() {
+			if setList, err := user.CreateSetList(ctx, "Warehouse", true); err != nil {
+				t.Error(err)
+			} else if setList.ID != 2 {
+				t.Errorf("unexpected set list: %v", setList)
+			}
+		})
+	})
+	t.Run("DeleteSetList", func(t *testing.T) {
+		globalMock.mockResponse(204, nil, func() {
+			if err := user.DeleteSetList(ctx, 2); err != nil {
+				t.Error(err)
+			}
+		})
+	})
+}
+
+func TestUserClient_AddSetListSet(t *testing.T) {
+	var form string
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		form = req.PostForm.Encode()
+		return mockClientResponse(201, `{"list_id": 2, "quantity": 3, "include_spares": false, "set": {"set_num": "42102-1"}}`), nil
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	if set, err := user.AddSetListSet(ctx, 2, "42102-1", 3, false); err != nil {
+		t.Fatal(err)
+	} else if set.Quantity != 3 {
+		t.Errorf("unexpected set: %v", set)
+	}
+	if expected := "include_spares=false&quantity=3&set_num=42102-1"; form != expected {
+		t.Errorf("expected form %v, got %v", expected, form)
+	}
+}
+
+func TestUserClient_SyncSets(t *testing.T) {
+	var path, body string
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		b, _ := ioutil.ReadAll(req.Body)
+		path, body = req.URL.Path, string(b)
+		return mockClientResponse(200, `{}`), nil
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	sets := []UserSet{
+		{Set: Set{SetNum: "42102-1"}, Quantity: 2, IncludeSpares: true},
+	}
+	if err := user.SyncSets(ctx, sets); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/api/v3/users/arandomtoken/sets/sync/" {
+		t.Errorf("unexpected path: %v", path)
+	}
+	if expected := `[{"set_num":"42102-1","quantity":2,"include_spares":true}]`; body != expected {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
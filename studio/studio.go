@@ -0,0 +1,104 @@
+// Package studio reads BrickLink Stud.io (.io) files, which are zip
+// archives containing an LDraw model, and converts them to Rebrickable
+// inventories using the ldraw package.
+package studio
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/thelolagemann/go-rebrickable"
+	"github.com/thelolagemann/go-rebrickable/ldraw"
+)
+
+// Password is the password Stud.io encrypts newer .io files with.
+const Password = "soho0909"
+
+// ModelFile is the name of the LDraw model within a .io file.
+const ModelFile = "model.ldr"
+
+// ErrNoModel is returned when a .io file does not contain a model.
+var ErrNoModel = errors.New("studio: no model in file")
+
+// Read reads the LDraw model from the .io file r of size bytes.
+func Read(r io.ReaderAt, size int64) (*ldraw.Model, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range zr.File {
+		if !strings.EqualFold(f.Name, ModelFile) {
+			continue
+		}
+		rc, err := open(f)
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ldraw.Parse(rc)
+	}
+
+	return nil, ErrNoModel
+}
+
+// Open reads the LDraw model from the .io file at path.
+func Open(path string) (*ldraw.Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Read(f, info.Size())
+}
+
+// Import reads the .io file at path and maps its parts to Rebrickable
+// parts and colours through catalog, returning the parts which could
+// not be mapped separately.
+func Import(ctx context.Context, catalog rebrickable.Catalog, path string) ([]rebrickable.InventoryPart, []ldraw.Part, error) {
+	model, err := Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	parts, err := model.Parts()
+	if err != nil {
+		return nil, nil, err
+	}
+	return ldraw.Inventory(ctx, catalog, parts)
+}
+
+// open opens f, decrypting it with Password if it is encrypted, which
+// archive/zip does not support.
+func open(f *zip.File) (io.ReadCloser, error) {
+	if f.Flags&0x1 == 0 {
+		return f.Open()
+	}
+
+	raw, err := f.OpenRaw()
+	if err != nil {
+		return nil, err
+	}
+	r, err := newDecrypter(raw, f, Password)
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.Method {
+	case zip.Store:
+		return io.NopCloser(r), nil
+	case zip.Deflate:
+		return flate.NewReader(r), nil
+	}
+	return nil, fmt.Errorf("studio: unsupported compression method %v", f.Method)
+}
@@ -0,0 +1,115 @@
+package studio
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"errors"
+	"hash/crc32"
+	"testing"
+)
+
+const testModel = "0 FILE model.ldr\n1 4 0 0 0 1 0 0 0 1 0 0 0 1 3001.dat\n1 4 0 -24 0 1 0 0 0 1 0 0 0 1 3001.dat\n"
+
+// writeIO returns a .io file containing model, encrypted with
+// password unless it is empty.
+func writeIO(t *testing.T, model, password string, method uint16) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if w, err := zw.Create(".info"); err != nil {
+		t.Fatal(err)
+	} else {
+		w.Write([]byte(`{"version":"2.0.0_1"}`))
+	}
+
+	if password == "" {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: ModelFile, Method: method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(model))
+	} else {
+		data := []byte(model)
+		if method == zip.Deflate {
+			var compressed bytes.Buffer
+			fw, _ := flate.NewWriter(&compressed, flate.DefaultCompression)
+			fw.Write(data)
+			fw.Close()
+			data = compressed.Bytes()
+		}
+
+		header := &zip.FileHeader{
+			Name:               ModelFile,
+			Method:             method,
+			Flags:              0x1,
+			CRC32:              crc32.ChecksumIEEE([]byte(model)),
+			UncompressedSize64: uint64(len(model)),
+			CompressedSize64:   uint64(len(data) + 12),
+		}
+		w, err := zw.CreateRaw(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encryption := append([]byte("0123456789a"), byte(header.CRC32>>24))
+		w.Write(encrypt(password, append(encryption, data...)))
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func encrypt(password string, p []byte) []byte {
+	z := newZipCrypto(password)
+	c := make([]byte, len(p))
+	for i, b := range p {
+		temp := z.keys[2] | 2
+		c[i] = b ^ byte((temp*(temp^1))>>8)
+		z.update(b)
+	}
+	return c
+}
+
+func TestRead(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		password string
+		method   uint16
+	}{
+		{"Plain", "", zip.Deflate},
+		{"EncryptedStore", Password, zip.Store},
+		{"EncryptedDeflate", Password, zip.Deflate},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := writeIO(t, testModel, tt.password, tt.method)
+			model, err := Read(r, r.Size())
+			if err != nil {
+				t.Fatal(err)
+			}
+			parts, err := model.Parts()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(parts) != 1 || parts[0].ID() != "3001" || parts[0].Quantity != 2 {
+				t.Errorf("unexpected parts %+v", parts)
+			}
+		})
+	}
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		r := writeIO(t, testModel, "password", zip.Store)
+		if _, err := Read(r, r.Size()); !errors.Is(err, ErrPassword) {
+			t.Errorf("expected ErrPassword, got %v", err)
+		}
+	})
+	t.Run("NoModel", func(t *testing.T) {
+		var buf bytes.Buffer
+		zip.NewWriter(&buf).Close()
+		if _, err := Read(bytes.NewReader(buf.Bytes()), int64(buf.Len())); !errors.Is(err, ErrNoModel) {
+			t.Errorf("expected ErrNoModel, got %v", err)
+		}
+	})
+}
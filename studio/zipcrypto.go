@@ -0,0 +1,81 @@
+package studio
+
+import (
+	"archive/zip"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+// ErrPassword is returned when an encrypted file cannot be decrypted
+// with Password.
+var ErrPassword = errors.New("studio: incorrect password")
+
+// zipCrypto holds the keys of the traditional PKWARE encryption,
+// as described in section 6.1 of the .ZIP File Format Specification.
+type zipCrypto struct {
+	keys [3]uint32
+}
+
+func newZipCrypto(password string) *zipCrypto {
+	z := &zipCrypto{[3]uint32{0x12345678, 0x23456789, 0x34567890}}
+	for i := 0; i < len(password); i++ {
+		z.update(password[i])
+	}
+	return z
+}
+
+func (z *zipCrypto) update(b byte) {
+	z.keys[0] = crc32Update(z.keys[0], b)
+	z.keys[1] = (z.keys[1]+z.keys[0]&0xff)*134775813 + 1
+	z.keys[2] = crc32Update(z.keys[2], byte(z.keys[1]>>24))
+}
+
+func (z *zipCrypto) decrypt(p []byte) {
+	for i, c := range p {
+		temp := z.keys[2] | 2
+		p[i] = c ^ byte((temp*(temp^1))>>8)
+		z.update(p[i])
+	}
+}
+
+func crc32Update(crc uint32, b byte) uint32 {
+	return crc>>8 ^ crc32.IEEETable[byte(crc)^b]
+}
+
+// decrypter decrypts the data read from an encrypted file.
+type decrypter struct {
+	r io.Reader
+	z *zipCrypto
+}
+
+// newDecrypter returns a reader decrypting the raw data of f read
+// from r, once its encryption header has been checked against
+// password.
+func newDecrypter(r io.Reader, f *zip.File, password string) (io.Reader, error) {
+	z := newZipCrypto(password)
+
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	z.decrypt(header)
+
+	// the last byte of the header is the high byte of the CRC, or of
+	// the modification time if the CRC follows the data
+	check := byte(f.CRC32 >> 24)
+	if f.Flags&0x8 != 0 {
+		check = byte(f.ModifiedTime >> 8)
+	}
+	if header[11] != check {
+		return nil, ErrPassword
+	}
+
+	return &decrypter{r, z}, nil
+}
+
+func (d *decrypter) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	d.z.decrypt(p[:n])
+	return n, err
+}
@@ -0,0 +1,201 @@
+package rebrickable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Translation is the result of translating a part number from an
+// external system to Rebrickable.
+type Translation struct {
+	System string
+	ID     string
+
+	// Parts are the Rebrickable parts with the ID, with the
+	// preferred part first.
+	Parts []Part
+
+	// Fallback is set when no part lists the ID as an external ID,
+	// and Parts holds the part with the same number instead. Such
+	// a part may not be the same part as the one in the system.
+	Fallback bool
+}
+
+// Found reports whether the ID maps to any Rebrickable part.
+func (t Translation) Found() bool {
+	return len(t.Parts) > 0
+}
+
+// Ambiguous reports whether the ID maps to several Rebrickable parts.
+func (t Translation) Ambiguous() bool {
+	return len(t.Parts) > 1
+}
+
+// Part returns the preferred Rebrickable part, or the zero Part if
+// the ID was not found.
+func (t Translation) Part() Part {
+	if !t.Found() {
+		return Part{}
+	}
+	return t.Parts[0]
+}
+
+// Translator translates part numbers from external systems to
+// Rebrickable parts, using the external ID filters of Parts and
+// remembering every translation. A Translator is safe for concurrent
+// use.
+type Translator struct {
+	catalog Catalog
+
+	mu           sync.Mutex
+	translations map[translationKey]Translation
+
+	// brickset indexes parts by their Brickset IDs, which have no
+	// filter of their own, once the first is translated
+	bricksetMu sync.Mutex
+	brickset   map[string][]Part
+}
+
+type translationKey struct {
+	system string
+	id     string
+}
+
+// NewTranslator returns a Translator looking parts up in catalog.
+func NewTranslator(catalog Catalog) *Translator {
+	return &Translator{catalog: catalog, translations: make(map[translationKey]Translation)}
+}
+
+// Translate translates the part number id from system, which is one
+// of SystemBrickLink, SystemBrickOwl, SystemBrickset, SystemLDraw or
+// SystemLEGO. When no part lists id as an external ID, the part with
+// the same number is used, if there is one, and the Translation is
+// marked as a Fallback. An ID which is not found is not an error.
+//
+// The first Brickset ID translated fetches every part, as Brickset
+// IDs can't be filtered on.
+func (t *Translator) Translate(ctx context.Context, system, id string) (Translation, error) {
+	key := translationKey{system, id}
+	t.mu.Lock()
+	translation, ok := t.translations[key]
+	t.mu.Unlock()
+	if ok {
+		return translation, nil
+	}
+
+	translation, err := t.lookup(ctx, system, id)
+	if err != nil {
+		return translation, err
+	}
+
+	t.mu.Lock()
+	t.translations[key] = translation
+	t.mu.Unlock()
+
+	return translation, nil
+}
+
+// TranslateAll translates each of ids from system, returning a
+// Translation for each in the same order.
+func (t *Translator) TranslateAll(ctx context.Context, system string, ids []string) ([]Translation, error) {
+	translations := make([]Translation, 0, len(ids))
+	for _, id := range ids {
+		translation, err := t.Translate(ctx, system, id)
+		if err != nil {
+			return nil, err
+		}
+		translations = append(translations, translation)
+	}
+	return translations, nil
+}
+
+// lookup returns the parts with the ID id in system, preferring the
+// part with the same number.
+func (t *Translator) lookup(ctx context.Context, system, id string) (Translation, error) {
+	translation := Translation{System: system, ID: id}
+
+	var opt RequestOption
+	switch system {
+	case SystemBrickLink:
+		opt = BrickLinkID(id)
+	case SystemBrickOwl:
+		opt = BrickOwlID(id)
+	case SystemLDraw:
+		opt = LDrawID(id)
+	case SystemLEGO:
+		opt = LEGOID(id)
+	case SystemBrickset:
+		// Brickset has no filter of its own
+	default:
+		return translation, fmt.Errorf("rebrickable: unknown system %q", system)
+	}
+
+	var (
+		candidates []Part
+		err        error
+	)
+	if opt != nil {
+		// catalogs without external IDs, such as the offline package,
+		// can only be looked up by part number
+		candidates, err = t.catalog.Parts(ctx, opt, IncPartDetails(true))
+		if err != nil && !errors.Is(err, ErrUnsupported) {
+			return translation, err
+		}
+	} else {
+		index, err := t.bricksetIndex(ctx)
+		if err != nil {
+			return translation, err
+		}
+		candidates = index[id]
+	}
+
+	for _, part := range candidates {
+		if part.PartNum == id {
+			translation.Parts = append([]Part{part}, translation.Parts...)
+		} else {
+			translation.Parts = append(translation.Parts, part)
+		}
+	}
+	if translation.Found() {
+		return translation, nil
+	}
+
+	// most parts have the same number in other systems, in which
+	// case it may not be listed as an external ID
+	part, err := t.catalog.Part(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return translation, nil
+	} else if err != nil {
+		return translation, err
+	}
+	translation.Parts, translation.Fallback = []Part{part}, true
+	return translation, nil
+}
+
+// bricksetIndex returns the parts of the catalog by their Brickset
+// IDs, fetching every part the first time it is called.
+func (t *Translator) bricksetIndex(ctx context.Context) (map[string][]Part, error) {
+	t.bricksetMu.Lock()
+	defer t.bricksetMu.Unlock()
+	if t.brickset != nil {
+		return t.brickset, nil
+	}
+
+	index := make(map[string][]Part)
+	err := eachPage(func(opts ...RequestOption) (int, error) {
+		parts, err := t.catalog.Parts(ctx, append(opts, IncPartDetails(true))...)
+		for _, part := range parts {
+			for _, id := range part.ExternalIds.Brickset {
+				index[id] = append(index[id], part)
+			}
+		}
+		return len(parts), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.brickset = index
+	return index, nil
+}
@@ -0,0 +1,91 @@
+package rebrickable_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thelolagemann/go-rebrickable"
+	"github.com/thelolagemann/go-rebrickable/internal/catalogtest"
+)
+
+var ctx = context.Background()
+
+func TestTranslator(t *testing.T) {
+	plate := rebrickable.Part{PartNum: "3794b"}
+	plate.ExternalIds.BrickLink = []string{"3794"}
+	plate.ExternalIds.Brickset = []string{"3794"}
+	plate.ExternalIds.LEGO = []string{"3794"}
+	jumper := rebrickable.Part{PartNum: "15573"}
+	jumper.ExternalIds.BrickLink = []string{"3794", "15573"}
+	jumper.ExternalIds.LEGO = []string{"15573", "3794"}
+	head := rebrickable.Part{PartNum: "3626cpr0001"}
+	head.ExternalIds.LDraw = []string{"3626cp01"}
+	tile := rebrickable.Part{PartNum: "3068b"}
+	tile.ExternalIds.Brickset = []string{"63327"}
+	tile.ExternalIds.LEGO = []string{"3068"}
+	catalog := catalogtest.New(nil, []rebrickable.Part{plate, jumper, head, tile, {PartNum: "3001"}})
+	translator := rebrickable.NewTranslator(catalog)
+
+	t.Run("Ambiguous", func(t *testing.T) {
+		translation, err := translator.Translate(ctx, rebrickable.SystemBrickLink, "3794")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !translation.Ambiguous() || translation.Part().PartNum != "3794b" {
+			t.Errorf("unexpected translation %+v", translation)
+		}
+	})
+	t.Run("Preferred", func(t *testing.T) {
+		translation, err := translator.Translate(ctx, rebrickable.SystemLEGO, "15573")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if translation.Ambiguous() || translation.Part().PartNum != "15573" {
+			t.Errorf("unexpected translation %+v", translation)
+		}
+	})
+	t.Run("Brickset", func(t *testing.T) {
+		translation, err := translator.Translate(ctx, rebrickable.SystemBrickset, "3794")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(translation.Parts) != 1 || translation.Part().PartNum != "3794b" || translation.Fallback {
+			t.Errorf("unexpected translation %+v", translation)
+		}
+
+		// Brickset IDs which differ from the LEGO ID
+		translation, err = translator.Translate(ctx, rebrickable.SystemBrickset, "63327")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if translation.Part().PartNum != "3068b" {
+			t.Errorf("unexpected translation %+v", translation)
+		}
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		translations, err := translator.TranslateAll(ctx, rebrickable.SystemLDraw, []string{"3626cp01", "3001", "99999"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if translations[0].Part().PartNum != "3626cpr0001" || translations[1].Part().PartNum != "3001" || translations[2].Found() {
+			t.Errorf("unexpected translations %+v", translations)
+		}
+		if translations[0].Fallback || !translations[1].Fallback {
+			t.Errorf("expected only 3001 to be a fallback, got %+v", translations)
+		}
+	})
+	t.Run("Cache", func(t *testing.T) {
+		requests := catalog.Requests
+		if _, err := translator.TranslateAll(ctx, rebrickable.SystemLDraw, []string{"3626cp01", "99999"}); err != nil {
+			t.Fatal(err)
+		}
+		if catalog.Requests != requests {
+			t.Errorf("expected no requests, got %v", catalog.Requests-requests)
+		}
+	})
+	t.Run("UnknownSystem", func(t *testing.T) {
+		if _, err := translator.Translate(ctx, rebrickable.SystemPeeron, "3001"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
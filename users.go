@@ -0,0 +1,119 @@
+package rebrickable
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// UserClient is used to access the endpoints of a single Rebrickable
+// user, identified by their user token.
+type UserClient struct {
+	c     *Client
+	token string
+}
+
+// Login exchanges a username (or email) and password for a user
+// token, returning a UserClient authenticated as that user.
+func (c *Client) Login(ctx context.Context, username, password string) (*UserClient, error) {
+	var res struct {
+		UserToken string `json:"user_token"`
+	}
+	form := url.Values{
+		"username": {username},
+		"password": {password},
+	}
+	if err := c.post(ctx, "users/_token/", form, &res); err != nil {
+		return nil, err
+	}
+	return c.User(res.UserToken), nil
+}
+
+// User returns a UserClient for a previously obtained user token.
+func (c *Client) User(token string) *UserClient {
+	return &UserClient{c, token}
+}
+
+// Token returns the user token used by the UserClient.
+func (u *UserClient) Token() string {
+	return u.token
+}
+
+func (u *UserClient) endpoint(endpoint string, a ...interface{}) string {
+	return fmt.Sprintf("users/%v/%v", url.PathEscape(u.token), fmt.Sprintf(endpoint, a...))
+}
+
+// Profile get details about the user.
+func (u *UserClient) Profile(ctx context.Context) (profile Profile, err error) {
+	err = u.c.get(ctx, u.endpoint("profile/"), false, &profile)
+	return
+}
+
+type Profile struct {
+	UserID            int       `json:"user_id"`
+	Username          string    `json:"username"`
+	Email             string    `json:"email"`
+	LastActivity      time.Time `json:"last_activity"`
+	LastIP            string    `json:"last_ip"`
+	Location          string    `json:"location"`
+	RebrickablePoints int       `json:"rebrickable_points"`
+
+	// LEGO holds the totals of the user's collection, such as
+	// total_sets and total_loose_parts.
+	LEGO      map[string]int `json:"lego"`
+	AvatarImg string         `json:"avatar_img"`
+}
+
+// AllParts get a list of all the UserPart in all the user's part
+// lists as well as the parts in all the sets in the user's set lists.
+func (u *UserClient) AllParts(ctx context.Context, opts ...RequestOption) (parts []UserPart, err error) {
+	err = u.c.get(ctx, u.endpoint("allparts/"), true, &parts, opts...)
+	return
+}
+
+// AllPartsPager returns a Pager over every page of AllParts, each
+// page decoding into a []UserPart.
+func (u *UserClient) AllPartsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("allparts/"), opts...)
+}
+
+// Parts get a list of all the UserPart in all the user's part lists.
+func (u *UserClient) Parts(ctx context.Context, opts ...RequestOption) (parts []UserPart, err error) {
+	err = u.c.get(ctx, u.endpoint("parts/"), true, &parts, opts...)
+	return
+}
+
+// PartsPager returns a Pager over every page of Parts, each page
+// decoding into a []UserPart.
+func (u *UserClient) PartsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("parts/"), opts...)
+}
+
+type UserPart struct {
+	Quantity int   `json:"quantity"`
+	Part     Part  `json:"part"`
+	Color    Color `json:"color"`
+}
+
+// InventoryPart returns the UserPart as an InventoryPart.
+func (p UserPart) InventoryPart() InventoryPart {
+	return InventoryPart{Part: p.Part, Color: p.Color, Quantity: p.Quantity}
+}
+
+// Minifigs get a list of all the UserMinifig in all the user's sets.
+func (u *UserClient) Minifigs(ctx context.Context, opts ...RequestOption) (minifigs []UserMinifig, err error) {
+	err = u.c.get(ctx, u.endpoint("minifigs/"), true, &minifigs, opts...)
+	return
+}
+
+// MinifigsPager returns a Pager over every page of Minifigs, each
+// page decoding into a []UserMinifig.
+func (u *UserClient) MinifigsPager(opts ...RequestOption) *Pager {
+	return u.c.newPager(u.endpoint("minifigs/"), opts...)
+}
+
+type UserMinifig struct {
+	Quantity int     `json:"quantity"`
+	Minifig  Minifig `json:"minifig"`
+}
@@ -0,0 +1,53 @@
+package rebrickable
+
+import (
+	"net/http"
+	"testing"
+)
+
+var user = client.User("arandomtoken")
+
+func TestClient_Login(t *testing.T) {
+	var form string
+	globalMock.mockDo = func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		form = req.PostForm.Encode()
+		return mockResponse(req)
+	}
+	defer func() { globalMock.mockDo = mockResponse }()
+
+	if _, err := client.Login(ctx, "user", "pass"); err != nil {
+		t.Error(err)
+	}
+	if form != "password=pass&username=user" {
+		t.Errorf("unexpected form: %v", form)
+	}
+}
+
+func TestUserClient_Profile(t *testing.T) {
+	if profile, err := user.Profile(ctx); err != nil {
+		t.Error(err)
+	} else if profile.Username != "arandomuser" || profile.LEGO["total_sets"] != 18 {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+}
+
+func TestUserClient_Parts(t *testing.T) {
+	t.Run("AllParts", func(t *testing.T) {
+		if parts, err := user.AllParts(ctx); err != nil {
+			t.Error(err)
+		} else if len(parts) == 0 || parts[0].Quantity != 2 {
+			t.Errorf("unexpected parts: %v", parts)
+		}
+	})
+}
+
+func TestUserClient_Minifigs(t *testing.T) {
+	if minifigs, err := user.Minifigs(ctx); err != nil {
+		t.Error(err)
+	} else if len(minifigs) == 0 || minifigs[0].Minifig.SetNum != "fig-000362" {
+		t.Errorf("unexpected minifigs: %v", minifigs)
+	}
+}